@@ -19,11 +19,39 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PatientsService_GetPatient_FullMethodName     = "/patients.PatientsService/GetPatient"
-	PatientsService_GetPatientsIDs_FullMethodName = "/patients.PatientsService/GetPatientsIDs"
-	PatientsService_CreatePatient_FullMethodName  = "/patients.PatientsService/CreatePatient"
-	PatientsService_DeletePatient_FullMethodName  = "/patients.PatientsService/DeletePatient"
-	PatientsService_UpdatePatient_FullMethodName  = "/patients.PatientsService/UpdatePatient"
+	PatientsService_GetPatient_FullMethodName              = "/patients.PatientsService/GetPatient"
+	PatientsService_GetPatientsIDs_FullMethodName          = "/patients.PatientsService/GetPatientsIDs"
+	PatientsService_BatchGetPatients_FullMethodName        = "/patients.PatientsService/BatchGetPatients"
+	PatientsService_SearchPatients_FullMethodName          = "/patients.PatientsService/SearchPatients"
+	PatientsService_CreatePatient_FullMethodName           = "/patients.PatientsService/CreatePatient"
+	PatientsService_DeletePatient_FullMethodName           = "/patients.PatientsService/DeletePatient"
+	PatientsService_UpdatePatient_FullMethodName           = "/patients.PatientsService/UpdatePatient"
+	PatientsService_PatchPatient_FullMethodName            = "/patients.PatientsService/PatchPatient"
+	PatientsService_RestorePatient_FullMethodName          = "/patients.PatientsService/RestorePatient"
+	PatientsService_ListDeletedPatients_FullMethodName     = "/patients.PatientsService/ListDeletedPatients"
+	PatientsService_PurgePatient_FullMethodName            = "/patients.PatientsService/PurgePatient"
+	PatientsService_GetPatientHistory_FullMethodName       = "/patients.PatientsService/GetPatientHistory"
+	PatientsService_ListPatientAccessLog_FullMethodName    = "/patients.PatientsService/ListPatientAccessLog"
+	PatientsService_AddEmergencyContact_FullMethodName     = "/patients.PatientsService/AddEmergencyContact"
+	PatientsService_UpdateEmergencyContact_FullMethodName  = "/patients.PatientsService/UpdateEmergencyContact"
+	PatientsService_RemoveEmergencyContact_FullMethodName  = "/patients.PatientsService/RemoveEmergencyContact"
+	PatientsService_ListEmergencyContacts_FullMethodName   = "/patients.PatientsService/ListEmergencyContacts"
+	PatientsService_FindPotentialDuplicates_FullMethodName = "/patients.PatientsService/FindPotentialDuplicates"
+	PatientsService_MergePatients_FullMethodName           = "/patients.PatientsService/MergePatients"
+	PatientsService_AddAllergy_FullMethodName              = "/patients.PatientsService/AddAllergy"
+	PatientsService_ResolveAllergy_FullMethodName          = "/patients.PatientsService/ResolveAllergy"
+	PatientsService_ListAllergies_FullMethodName           = "/patients.PatientsService/ListAllergies"
+	PatientsService_AddCondition_FullMethodName            = "/patients.PatientsService/AddCondition"
+	PatientsService_ResolveCondition_FullMethodName        = "/patients.PatientsService/ResolveCondition"
+	PatientsService_ListConditions_FullMethodName          = "/patients.PatientsService/ListConditions"
+	PatientsService_AddMedication_FullMethodName           = "/patients.PatientsService/AddMedication"
+	PatientsService_UpdateMedication_FullMethodName        = "/patients.PatientsService/UpdateMedication"
+	PatientsService_RemoveMedication_FullMethodName        = "/patients.PatientsService/RemoveMedication"
+	PatientsService_ListMedications_FullMethodName         = "/patients.PatientsService/ListMedications"
+	PatientsService_ExportPatient_FullMethodName           = "/patients.PatientsService/ExportPatient"
+	PatientsService_AddInsurance_FullMethodName            = "/patients.PatientsService/AddInsurance"
+	PatientsService_UpdateInsurance_FullMethodName         = "/patients.PatientsService/UpdateInsurance"
+	PatientsService_RemoveInsurance_FullMethodName         = "/patients.PatientsService/RemoveInsurance"
 )
 
 // PatientsServiceClient is the client API for PatientsService service.
@@ -32,9 +60,37 @@ const (
 type PatientsServiceClient interface {
 	GetPatient(ctx context.Context, in *GetPatientRequest, opts ...grpc.CallOption) (*GetPatientResponse, error)
 	GetPatientsIDs(ctx context.Context, in *GetPatientsIDsRequest, opts ...grpc.CallOption) (*GetPatientsIDsResponse, error)
+	BatchGetPatients(ctx context.Context, in *BatchGetPatientsRequest, opts ...grpc.CallOption) (*BatchGetPatientsResponse, error)
+	SearchPatients(ctx context.Context, in *SearchPatientsRequest, opts ...grpc.CallOption) (*SearchPatientsResponse, error)
 	CreatePatient(ctx context.Context, in *CreatePatientRequest, opts ...grpc.CallOption) (*CreatePatientResponse, error)
 	DeletePatient(ctx context.Context, in *DeletePatientRequest, opts ...grpc.CallOption) (*DeletePatientResponse, error)
 	UpdatePatient(ctx context.Context, in *UpdatePatientRequest, opts ...grpc.CallOption) (*UpdatePatientResponse, error)
+	PatchPatient(ctx context.Context, in *PatchPatientRequest, opts ...grpc.CallOption) (*PatchPatientResponse, error)
+	RestorePatient(ctx context.Context, in *RestorePatientRequest, opts ...grpc.CallOption) (*RestorePatientResponse, error)
+	ListDeletedPatients(ctx context.Context, in *ListDeletedPatientsRequest, opts ...grpc.CallOption) (*ListDeletedPatientsResponse, error)
+	PurgePatient(ctx context.Context, in *PurgePatientRequest, opts ...grpc.CallOption) (*PurgePatientResponse, error)
+	GetPatientHistory(ctx context.Context, in *GetPatientHistoryRequest, opts ...grpc.CallOption) (*GetPatientHistoryResponse, error)
+	ListPatientAccessLog(ctx context.Context, in *ListPatientAccessLogRequest, opts ...grpc.CallOption) (*ListPatientAccessLogResponse, error)
+	AddEmergencyContact(ctx context.Context, in *AddEmergencyContactRequest, opts ...grpc.CallOption) (*AddEmergencyContactResponse, error)
+	UpdateEmergencyContact(ctx context.Context, in *UpdateEmergencyContactRequest, opts ...grpc.CallOption) (*UpdateEmergencyContactResponse, error)
+	RemoveEmergencyContact(ctx context.Context, in *RemoveEmergencyContactRequest, opts ...grpc.CallOption) (*RemoveEmergencyContactResponse, error)
+	ListEmergencyContacts(ctx context.Context, in *ListEmergencyContactsRequest, opts ...grpc.CallOption) (*ListEmergencyContactsResponse, error)
+	FindPotentialDuplicates(ctx context.Context, in *FindPotentialDuplicatesRequest, opts ...grpc.CallOption) (*FindPotentialDuplicatesResponse, error)
+	MergePatients(ctx context.Context, in *MergePatientsRequest, opts ...grpc.CallOption) (*MergePatientsResponse, error)
+	AddAllergy(ctx context.Context, in *AddAllergyRequest, opts ...grpc.CallOption) (*AddAllergyResponse, error)
+	ResolveAllergy(ctx context.Context, in *ResolveAllergyRequest, opts ...grpc.CallOption) (*ResolveAllergyResponse, error)
+	ListAllergies(ctx context.Context, in *ListAllergiesRequest, opts ...grpc.CallOption) (*ListAllergiesResponse, error)
+	AddCondition(ctx context.Context, in *AddConditionRequest, opts ...grpc.CallOption) (*AddConditionResponse, error)
+	ResolveCondition(ctx context.Context, in *ResolveConditionRequest, opts ...grpc.CallOption) (*ResolveConditionResponse, error)
+	ListConditions(ctx context.Context, in *ListConditionsRequest, opts ...grpc.CallOption) (*ListConditionsResponse, error)
+	AddMedication(ctx context.Context, in *AddMedicationRequest, opts ...grpc.CallOption) (*AddMedicationResponse, error)
+	UpdateMedication(ctx context.Context, in *UpdateMedicationRequest, opts ...grpc.CallOption) (*UpdateMedicationResponse, error)
+	RemoveMedication(ctx context.Context, in *RemoveMedicationRequest, opts ...grpc.CallOption) (*RemoveMedicationResponse, error)
+	ListMedications(ctx context.Context, in *ListMedicationsRequest, opts ...grpc.CallOption) (*ListMedicationsResponse, error)
+	ExportPatient(ctx context.Context, in *ExportPatientRequest, opts ...grpc.CallOption) (*ExportPatientResponse, error)
+	AddInsurance(ctx context.Context, in *AddInsuranceRequest, opts ...grpc.CallOption) (*AddInsuranceResponse, error)
+	UpdateInsurance(ctx context.Context, in *UpdateInsuranceRequest, opts ...grpc.CallOption) (*UpdateInsuranceResponse, error)
+	RemoveInsurance(ctx context.Context, in *RemoveInsuranceRequest, opts ...grpc.CallOption) (*RemoveInsuranceResponse, error)
 }
 
 type patientsServiceClient struct {
@@ -65,6 +121,26 @@ func (c *patientsServiceClient) GetPatientsIDs(ctx context.Context, in *GetPatie
 	return out, nil
 }
 
+func (c *patientsServiceClient) BatchGetPatients(ctx context.Context, in *BatchGetPatientsRequest, opts ...grpc.CallOption) (*BatchGetPatientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetPatientsResponse)
+	err := c.cc.Invoke(ctx, PatientsService_BatchGetPatients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) SearchPatients(ctx context.Context, in *SearchPatientsRequest, opts ...grpc.CallOption) (*SearchPatientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPatientsResponse)
+	err := c.cc.Invoke(ctx, PatientsService_SearchPatients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *patientsServiceClient) CreatePatient(ctx context.Context, in *CreatePatientRequest, opts ...grpc.CallOption) (*CreatePatientResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreatePatientResponse)
@@ -95,15 +171,303 @@ func (c *patientsServiceClient) UpdatePatient(ctx context.Context, in *UpdatePat
 	return out, nil
 }
 
+func (c *patientsServiceClient) PatchPatient(ctx context.Context, in *PatchPatientRequest, opts ...grpc.CallOption) (*PatchPatientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatchPatientResponse)
+	err := c.cc.Invoke(ctx, PatientsService_PatchPatient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) RestorePatient(ctx context.Context, in *RestorePatientRequest, opts ...grpc.CallOption) (*RestorePatientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestorePatientResponse)
+	err := c.cc.Invoke(ctx, PatientsService_RestorePatient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) ListDeletedPatients(ctx context.Context, in *ListDeletedPatientsRequest, opts ...grpc.CallOption) (*ListDeletedPatientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedPatientsResponse)
+	err := c.cc.Invoke(ctx, PatientsService_ListDeletedPatients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) PurgePatient(ctx context.Context, in *PurgePatientRequest, opts ...grpc.CallOption) (*PurgePatientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgePatientResponse)
+	err := c.cc.Invoke(ctx, PatientsService_PurgePatient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) GetPatientHistory(ctx context.Context, in *GetPatientHistoryRequest, opts ...grpc.CallOption) (*GetPatientHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPatientHistoryResponse)
+	err := c.cc.Invoke(ctx, PatientsService_GetPatientHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) ListPatientAccessLog(ctx context.Context, in *ListPatientAccessLogRequest, opts ...grpc.CallOption) (*ListPatientAccessLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPatientAccessLogResponse)
+	err := c.cc.Invoke(ctx, PatientsService_ListPatientAccessLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) AddEmergencyContact(ctx context.Context, in *AddEmergencyContactRequest, opts ...grpc.CallOption) (*AddEmergencyContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddEmergencyContactResponse)
+	err := c.cc.Invoke(ctx, PatientsService_AddEmergencyContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) UpdateEmergencyContact(ctx context.Context, in *UpdateEmergencyContactRequest, opts ...grpc.CallOption) (*UpdateEmergencyContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEmergencyContactResponse)
+	err := c.cc.Invoke(ctx, PatientsService_UpdateEmergencyContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) RemoveEmergencyContact(ctx context.Context, in *RemoveEmergencyContactRequest, opts ...grpc.CallOption) (*RemoveEmergencyContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveEmergencyContactResponse)
+	err := c.cc.Invoke(ctx, PatientsService_RemoveEmergencyContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) ListEmergencyContacts(ctx context.Context, in *ListEmergencyContactsRequest, opts ...grpc.CallOption) (*ListEmergencyContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmergencyContactsResponse)
+	err := c.cc.Invoke(ctx, PatientsService_ListEmergencyContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) FindPotentialDuplicates(ctx context.Context, in *FindPotentialDuplicatesRequest, opts ...grpc.CallOption) (*FindPotentialDuplicatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindPotentialDuplicatesResponse)
+	err := c.cc.Invoke(ctx, PatientsService_FindPotentialDuplicates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) MergePatients(ctx context.Context, in *MergePatientsRequest, opts ...grpc.CallOption) (*MergePatientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergePatientsResponse)
+	err := c.cc.Invoke(ctx, PatientsService_MergePatients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) AddAllergy(ctx context.Context, in *AddAllergyRequest, opts ...grpc.CallOption) (*AddAllergyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddAllergyResponse)
+	err := c.cc.Invoke(ctx, PatientsService_AddAllergy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) ResolveAllergy(ctx context.Context, in *ResolveAllergyRequest, opts ...grpc.CallOption) (*ResolveAllergyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveAllergyResponse)
+	err := c.cc.Invoke(ctx, PatientsService_ResolveAllergy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) ListAllergies(ctx context.Context, in *ListAllergiesRequest, opts ...grpc.CallOption) (*ListAllergiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAllergiesResponse)
+	err := c.cc.Invoke(ctx, PatientsService_ListAllergies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) AddCondition(ctx context.Context, in *AddConditionRequest, opts ...grpc.CallOption) (*AddConditionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddConditionResponse)
+	err := c.cc.Invoke(ctx, PatientsService_AddCondition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) ResolveCondition(ctx context.Context, in *ResolveConditionRequest, opts ...grpc.CallOption) (*ResolveConditionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveConditionResponse)
+	err := c.cc.Invoke(ctx, PatientsService_ResolveCondition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) ListConditions(ctx context.Context, in *ListConditionsRequest, opts ...grpc.CallOption) (*ListConditionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConditionsResponse)
+	err := c.cc.Invoke(ctx, PatientsService_ListConditions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) AddMedication(ctx context.Context, in *AddMedicationRequest, opts ...grpc.CallOption) (*AddMedicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddMedicationResponse)
+	err := c.cc.Invoke(ctx, PatientsService_AddMedication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) UpdateMedication(ctx context.Context, in *UpdateMedicationRequest, opts ...grpc.CallOption) (*UpdateMedicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMedicationResponse)
+	err := c.cc.Invoke(ctx, PatientsService_UpdateMedication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) RemoveMedication(ctx context.Context, in *RemoveMedicationRequest, opts ...grpc.CallOption) (*RemoveMedicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveMedicationResponse)
+	err := c.cc.Invoke(ctx, PatientsService_RemoveMedication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) ListMedications(ctx context.Context, in *ListMedicationsRequest, opts ...grpc.CallOption) (*ListMedicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMedicationsResponse)
+	err := c.cc.Invoke(ctx, PatientsService_ListMedications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) ExportPatient(ctx context.Context, in *ExportPatientRequest, opts ...grpc.CallOption) (*ExportPatientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportPatientResponse)
+	err := c.cc.Invoke(ctx, PatientsService_ExportPatient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) AddInsurance(ctx context.Context, in *AddInsuranceRequest, opts ...grpc.CallOption) (*AddInsuranceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddInsuranceResponse)
+	err := c.cc.Invoke(ctx, PatientsService_AddInsurance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) UpdateInsurance(ctx context.Context, in *UpdateInsuranceRequest, opts ...grpc.CallOption) (*UpdateInsuranceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateInsuranceResponse)
+	err := c.cc.Invoke(ctx, PatientsService_UpdateInsurance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientsServiceClient) RemoveInsurance(ctx context.Context, in *RemoveInsuranceRequest, opts ...grpc.CallOption) (*RemoveInsuranceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveInsuranceResponse)
+	err := c.cc.Invoke(ctx, PatientsService_RemoveInsurance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PatientsServiceServer is the server API for PatientsService service.
 // All implementations must embed UnimplementedPatientsServiceServer
 // for forward compatibility.
 type PatientsServiceServer interface {
 	GetPatient(context.Context, *GetPatientRequest) (*GetPatientResponse, error)
 	GetPatientsIDs(context.Context, *GetPatientsIDsRequest) (*GetPatientsIDsResponse, error)
+	BatchGetPatients(context.Context, *BatchGetPatientsRequest) (*BatchGetPatientsResponse, error)
+	SearchPatients(context.Context, *SearchPatientsRequest) (*SearchPatientsResponse, error)
 	CreatePatient(context.Context, *CreatePatientRequest) (*CreatePatientResponse, error)
 	DeletePatient(context.Context, *DeletePatientRequest) (*DeletePatientResponse, error)
 	UpdatePatient(context.Context, *UpdatePatientRequest) (*UpdatePatientResponse, error)
+	PatchPatient(context.Context, *PatchPatientRequest) (*PatchPatientResponse, error)
+	RestorePatient(context.Context, *RestorePatientRequest) (*RestorePatientResponse, error)
+	ListDeletedPatients(context.Context, *ListDeletedPatientsRequest) (*ListDeletedPatientsResponse, error)
+	PurgePatient(context.Context, *PurgePatientRequest) (*PurgePatientResponse, error)
+	GetPatientHistory(context.Context, *GetPatientHistoryRequest) (*GetPatientHistoryResponse, error)
+	ListPatientAccessLog(context.Context, *ListPatientAccessLogRequest) (*ListPatientAccessLogResponse, error)
+	AddEmergencyContact(context.Context, *AddEmergencyContactRequest) (*AddEmergencyContactResponse, error)
+	UpdateEmergencyContact(context.Context, *UpdateEmergencyContactRequest) (*UpdateEmergencyContactResponse, error)
+	RemoveEmergencyContact(context.Context, *RemoveEmergencyContactRequest) (*RemoveEmergencyContactResponse, error)
+	ListEmergencyContacts(context.Context, *ListEmergencyContactsRequest) (*ListEmergencyContactsResponse, error)
+	FindPotentialDuplicates(context.Context, *FindPotentialDuplicatesRequest) (*FindPotentialDuplicatesResponse, error)
+	MergePatients(context.Context, *MergePatientsRequest) (*MergePatientsResponse, error)
+	AddAllergy(context.Context, *AddAllergyRequest) (*AddAllergyResponse, error)
+	ResolveAllergy(context.Context, *ResolveAllergyRequest) (*ResolveAllergyResponse, error)
+	ListAllergies(context.Context, *ListAllergiesRequest) (*ListAllergiesResponse, error)
+	AddCondition(context.Context, *AddConditionRequest) (*AddConditionResponse, error)
+	ResolveCondition(context.Context, *ResolveConditionRequest) (*ResolveConditionResponse, error)
+	ListConditions(context.Context, *ListConditionsRequest) (*ListConditionsResponse, error)
+	AddMedication(context.Context, *AddMedicationRequest) (*AddMedicationResponse, error)
+	UpdateMedication(context.Context, *UpdateMedicationRequest) (*UpdateMedicationResponse, error)
+	RemoveMedication(context.Context, *RemoveMedicationRequest) (*RemoveMedicationResponse, error)
+	ListMedications(context.Context, *ListMedicationsRequest) (*ListMedicationsResponse, error)
+	ExportPatient(context.Context, *ExportPatientRequest) (*ExportPatientResponse, error)
+	AddInsurance(context.Context, *AddInsuranceRequest) (*AddInsuranceResponse, error)
+	UpdateInsurance(context.Context, *UpdateInsuranceRequest) (*UpdateInsuranceResponse, error)
+	RemoveInsurance(context.Context, *RemoveInsuranceRequest) (*RemoveInsuranceResponse, error)
 	mustEmbedUnimplementedPatientsServiceServer()
 }
 
@@ -120,6 +484,12 @@ func (UnimplementedPatientsServiceServer) GetPatient(context.Context, *GetPatien
 func (UnimplementedPatientsServiceServer) GetPatientsIDs(context.Context, *GetPatientsIDsRequest) (*GetPatientsIDsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPatientsIDs not implemented")
 }
+func (UnimplementedPatientsServiceServer) BatchGetPatients(context.Context, *BatchGetPatientsRequest) (*BatchGetPatientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetPatients not implemented")
+}
+func (UnimplementedPatientsServiceServer) SearchPatients(context.Context, *SearchPatientsRequest) (*SearchPatientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPatients not implemented")
+}
 func (UnimplementedPatientsServiceServer) CreatePatient(context.Context, *CreatePatientRequest) (*CreatePatientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePatient not implemented")
 }
@@ -129,6 +499,84 @@ func (UnimplementedPatientsServiceServer) DeletePatient(context.Context, *Delete
 func (UnimplementedPatientsServiceServer) UpdatePatient(context.Context, *UpdatePatientRequest) (*UpdatePatientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePatient not implemented")
 }
+func (UnimplementedPatientsServiceServer) PatchPatient(context.Context, *PatchPatientRequest) (*PatchPatientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchPatient not implemented")
+}
+func (UnimplementedPatientsServiceServer) RestorePatient(context.Context, *RestorePatientRequest) (*RestorePatientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePatient not implemented")
+}
+func (UnimplementedPatientsServiceServer) ListDeletedPatients(context.Context, *ListDeletedPatientsRequest) (*ListDeletedPatientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedPatients not implemented")
+}
+func (UnimplementedPatientsServiceServer) PurgePatient(context.Context, *PurgePatientRequest) (*PurgePatientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgePatient not implemented")
+}
+func (UnimplementedPatientsServiceServer) GetPatientHistory(context.Context, *GetPatientHistoryRequest) (*GetPatientHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPatientHistory not implemented")
+}
+func (UnimplementedPatientsServiceServer) ListPatientAccessLog(context.Context, *ListPatientAccessLogRequest) (*ListPatientAccessLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPatientAccessLog not implemented")
+}
+func (UnimplementedPatientsServiceServer) AddEmergencyContact(context.Context, *AddEmergencyContactRequest) (*AddEmergencyContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEmergencyContact not implemented")
+}
+func (UnimplementedPatientsServiceServer) UpdateEmergencyContact(context.Context, *UpdateEmergencyContactRequest) (*UpdateEmergencyContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEmergencyContact not implemented")
+}
+func (UnimplementedPatientsServiceServer) RemoveEmergencyContact(context.Context, *RemoveEmergencyContactRequest) (*RemoveEmergencyContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEmergencyContact not implemented")
+}
+func (UnimplementedPatientsServiceServer) ListEmergencyContacts(context.Context, *ListEmergencyContactsRequest) (*ListEmergencyContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmergencyContacts not implemented")
+}
+func (UnimplementedPatientsServiceServer) FindPotentialDuplicates(context.Context, *FindPotentialDuplicatesRequest) (*FindPotentialDuplicatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPotentialDuplicates not implemented")
+}
+func (UnimplementedPatientsServiceServer) MergePatients(context.Context, *MergePatientsRequest) (*MergePatientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergePatients not implemented")
+}
+func (UnimplementedPatientsServiceServer) AddAllergy(context.Context, *AddAllergyRequest) (*AddAllergyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAllergy not implemented")
+}
+func (UnimplementedPatientsServiceServer) ResolveAllergy(context.Context, *ResolveAllergyRequest) (*ResolveAllergyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveAllergy not implemented")
+}
+func (UnimplementedPatientsServiceServer) ListAllergies(context.Context, *ListAllergiesRequest) (*ListAllergiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllergies not implemented")
+}
+func (UnimplementedPatientsServiceServer) AddCondition(context.Context, *AddConditionRequest) (*AddConditionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCondition not implemented")
+}
+func (UnimplementedPatientsServiceServer) ResolveCondition(context.Context, *ResolveConditionRequest) (*ResolveConditionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveCondition not implemented")
+}
+func (UnimplementedPatientsServiceServer) ListConditions(context.Context, *ListConditionsRequest) (*ListConditionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConditions not implemented")
+}
+func (UnimplementedPatientsServiceServer) AddMedication(context.Context, *AddMedicationRequest) (*AddMedicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMedication not implemented")
+}
+func (UnimplementedPatientsServiceServer) UpdateMedication(context.Context, *UpdateMedicationRequest) (*UpdateMedicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMedication not implemented")
+}
+func (UnimplementedPatientsServiceServer) RemoveMedication(context.Context, *RemoveMedicationRequest) (*RemoveMedicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMedication not implemented")
+}
+func (UnimplementedPatientsServiceServer) ListMedications(context.Context, *ListMedicationsRequest) (*ListMedicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMedications not implemented")
+}
+func (UnimplementedPatientsServiceServer) ExportPatient(context.Context, *ExportPatientRequest) (*ExportPatientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPatient not implemented")
+}
+func (UnimplementedPatientsServiceServer) AddInsurance(context.Context, *AddInsuranceRequest) (*AddInsuranceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddInsurance not implemented")
+}
+func (UnimplementedPatientsServiceServer) UpdateInsurance(context.Context, *UpdateInsuranceRequest) (*UpdateInsuranceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInsurance not implemented")
+}
+func (UnimplementedPatientsServiceServer) RemoveInsurance(context.Context, *RemoveInsuranceRequest) (*RemoveInsuranceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveInsurance not implemented")
+}
 func (UnimplementedPatientsServiceServer) mustEmbedUnimplementedPatientsServiceServer() {}
 func (UnimplementedPatientsServiceServer) testEmbeddedByValue()                         {}
 
@@ -186,6 +634,42 @@ func _PatientsService_GetPatientsIDs_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PatientsService_BatchGetPatients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetPatientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).BatchGetPatients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_BatchGetPatients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).BatchGetPatients(ctx, req.(*BatchGetPatientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_SearchPatients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPatientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).SearchPatients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_SearchPatients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).SearchPatients(ctx, req.(*SearchPatientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PatientsService_CreatePatient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePatientRequest)
 	if err := dec(in); err != nil {
@@ -240,12 +724,480 @@ func _PatientsService_UpdatePatient_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-// PatientsService_ServiceDesc is the grpc.ServiceDesc for PatientsService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var PatientsService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "patients.PatientsService",
-	HandlerType: (*PatientsServiceServer)(nil),
+func _PatientsService_PatchPatient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchPatientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).PatchPatient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_PatchPatient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).PatchPatient(ctx, req.(*PatchPatientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_RestorePatient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePatientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).RestorePatient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_RestorePatient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).RestorePatient(ctx, req.(*RestorePatientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_ListDeletedPatients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedPatientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).ListDeletedPatients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_ListDeletedPatients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).ListDeletedPatients(ctx, req.(*ListDeletedPatientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_PurgePatient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgePatientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).PurgePatient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_PurgePatient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).PurgePatient(ctx, req.(*PurgePatientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_GetPatientHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPatientHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).GetPatientHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_GetPatientHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).GetPatientHistory(ctx, req.(*GetPatientHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_ListPatientAccessLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPatientAccessLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).ListPatientAccessLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_ListPatientAccessLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).ListPatientAccessLog(ctx, req.(*ListPatientAccessLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_AddEmergencyContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEmergencyContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).AddEmergencyContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_AddEmergencyContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).AddEmergencyContact(ctx, req.(*AddEmergencyContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_UpdateEmergencyContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEmergencyContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).UpdateEmergencyContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_UpdateEmergencyContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).UpdateEmergencyContact(ctx, req.(*UpdateEmergencyContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_RemoveEmergencyContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEmergencyContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).RemoveEmergencyContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_RemoveEmergencyContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).RemoveEmergencyContact(ctx, req.(*RemoveEmergencyContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_ListEmergencyContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmergencyContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).ListEmergencyContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_ListEmergencyContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).ListEmergencyContacts(ctx, req.(*ListEmergencyContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_FindPotentialDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPotentialDuplicatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).FindPotentialDuplicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_FindPotentialDuplicates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).FindPotentialDuplicates(ctx, req.(*FindPotentialDuplicatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_MergePatients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergePatientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).MergePatients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_MergePatients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).MergePatients(ctx, req.(*MergePatientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_AddAllergy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAllergyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).AddAllergy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_AddAllergy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).AddAllergy(ctx, req.(*AddAllergyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_ResolveAllergy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveAllergyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).ResolveAllergy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_ResolveAllergy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).ResolveAllergy(ctx, req.(*ResolveAllergyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_ListAllergies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllergiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).ListAllergies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_ListAllergies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).ListAllergies(ctx, req.(*ListAllergiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_AddCondition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddConditionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).AddCondition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_AddCondition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).AddCondition(ctx, req.(*AddConditionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_ResolveCondition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveConditionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).ResolveCondition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_ResolveCondition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).ResolveCondition(ctx, req.(*ResolveConditionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_ListConditions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConditionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).ListConditions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_ListConditions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).ListConditions(ctx, req.(*ListConditionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_AddMedication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMedicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).AddMedication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_AddMedication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).AddMedication(ctx, req.(*AddMedicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_UpdateMedication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMedicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).UpdateMedication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_UpdateMedication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).UpdateMedication(ctx, req.(*UpdateMedicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_RemoveMedication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMedicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).RemoveMedication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_RemoveMedication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).RemoveMedication(ctx, req.(*RemoveMedicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_ListMedications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMedicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).ListMedications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_ListMedications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).ListMedications(ctx, req.(*ListMedicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_ExportPatient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPatientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).ExportPatient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_ExportPatient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).ExportPatient(ctx, req.(*ExportPatientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_AddInsurance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddInsuranceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).AddInsurance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_AddInsurance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).AddInsurance(ctx, req.(*AddInsuranceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_UpdateInsurance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInsuranceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).UpdateInsurance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_UpdateInsurance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).UpdateInsurance(ctx, req.(*UpdateInsuranceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientsService_RemoveInsurance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveInsuranceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientsServiceServer).RemoveInsurance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientsService_RemoveInsurance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientsServiceServer).RemoveInsurance(ctx, req.(*RemoveInsuranceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PatientsService_ServiceDesc is the grpc.ServiceDesc for PatientsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PatientsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "patients.PatientsService",
+	HandlerType: (*PatientsServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "GetPatient",
@@ -255,6 +1207,14 @@ var PatientsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPatientsIDs",
 			Handler:    _PatientsService_GetPatientsIDs_Handler,
 		},
+		{
+			MethodName: "BatchGetPatients",
+			Handler:    _PatientsService_BatchGetPatients_Handler,
+		},
+		{
+			MethodName: "SearchPatients",
+			Handler:    _PatientsService_SearchPatients_Handler,
+		},
 		{
 			MethodName: "CreatePatient",
 			Handler:    _PatientsService_CreatePatient_Handler,
@@ -267,6 +1227,110 @@ var PatientsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePatient",
 			Handler:    _PatientsService_UpdatePatient_Handler,
 		},
+		{
+			MethodName: "PatchPatient",
+			Handler:    _PatientsService_PatchPatient_Handler,
+		},
+		{
+			MethodName: "RestorePatient",
+			Handler:    _PatientsService_RestorePatient_Handler,
+		},
+		{
+			MethodName: "ListDeletedPatients",
+			Handler:    _PatientsService_ListDeletedPatients_Handler,
+		},
+		{
+			MethodName: "PurgePatient",
+			Handler:    _PatientsService_PurgePatient_Handler,
+		},
+		{
+			MethodName: "GetPatientHistory",
+			Handler:    _PatientsService_GetPatientHistory_Handler,
+		},
+		{
+			MethodName: "ListPatientAccessLog",
+			Handler:    _PatientsService_ListPatientAccessLog_Handler,
+		},
+		{
+			MethodName: "AddEmergencyContact",
+			Handler:    _PatientsService_AddEmergencyContact_Handler,
+		},
+		{
+			MethodName: "UpdateEmergencyContact",
+			Handler:    _PatientsService_UpdateEmergencyContact_Handler,
+		},
+		{
+			MethodName: "RemoveEmergencyContact",
+			Handler:    _PatientsService_RemoveEmergencyContact_Handler,
+		},
+		{
+			MethodName: "ListEmergencyContacts",
+			Handler:    _PatientsService_ListEmergencyContacts_Handler,
+		},
+		{
+			MethodName: "FindPotentialDuplicates",
+			Handler:    _PatientsService_FindPotentialDuplicates_Handler,
+		},
+		{
+			MethodName: "MergePatients",
+			Handler:    _PatientsService_MergePatients_Handler,
+		},
+		{
+			MethodName: "AddAllergy",
+			Handler:    _PatientsService_AddAllergy_Handler,
+		},
+		{
+			MethodName: "ResolveAllergy",
+			Handler:    _PatientsService_ResolveAllergy_Handler,
+		},
+		{
+			MethodName: "ListAllergies",
+			Handler:    _PatientsService_ListAllergies_Handler,
+		},
+		{
+			MethodName: "AddCondition",
+			Handler:    _PatientsService_AddCondition_Handler,
+		},
+		{
+			MethodName: "ResolveCondition",
+			Handler:    _PatientsService_ResolveCondition_Handler,
+		},
+		{
+			MethodName: "ListConditions",
+			Handler:    _PatientsService_ListConditions_Handler,
+		},
+		{
+			MethodName: "AddMedication",
+			Handler:    _PatientsService_AddMedication_Handler,
+		},
+		{
+			MethodName: "UpdateMedication",
+			Handler:    _PatientsService_UpdateMedication_Handler,
+		},
+		{
+			MethodName: "RemoveMedication",
+			Handler:    _PatientsService_RemoveMedication_Handler,
+		},
+		{
+			MethodName: "ListMedications",
+			Handler:    _PatientsService_ListMedications_Handler,
+		},
+		{
+			MethodName: "ExportPatient",
+			Handler:    _PatientsService_ExportPatient_Handler,
+		},
+		{
+			MethodName: "AddInsurance",
+			Handler:    _PatientsService_AddInsurance_Handler,
+		},
+		{
+			MethodName: "UpdateInsurance",
+			Handler:    _PatientsService_UpdateInsurance_Handler,
+		},
+		{
+			MethodName: "RemoveInsurance",
+			Handler:    _PatientsService_RemoveInsurance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "patients_service.proto",
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	ms "github.com/TekClinic/MicroService-Lib"
+	ppb "github.com/TekClinic/Patients-MicroService/patients_protobuf"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// permission is a named capability that is required by RPCs and granted to roles.
+type permission string
+
+const (
+	permissionRead   permission = "patients:read"
+	permissionWrite  permission = "patients:write"
+	permissionDelete permission = "patients:delete"
+	// permissionPurge allows permanent erasure of patients, it is not granted by default.
+	permissionPurge permission = "patients:purge"
+	permissionAudit permission = "patients:audit"
+
+	envRolePermissions = "ROLE_PERMISSIONS"
+	// defaultRolePermissions keeps the original behaviour, where only admins can access the service.
+	defaultRolePermissions = "admin=patients:read,patients:write,patients:delete,patients:audit"
+
+	rolesSeparator       = ";"
+	roleAssignment       = "="
+	permissionsSeparator = ","
+
+	jwtSeparator = "."
+	jwtParts     = 3
+)
+
+// knownPermissions returns all permissions that may be granted to roles.
+func knownPermissions() map[permission]struct{} {
+	return map[permission]struct{}{
+		permissionRead:   {},
+		permissionWrite:  {},
+		permissionDelete: {},
+		permissionPurge:  {},
+		permissionAudit:  {},
+	}
+}
+
+// methodPermissions returns a table that maps every RPC of the service to the permission it requires.
+// RPCs that are missing from the table are always denied.
+func methodPermissions() map[string]permission {
+	return map[string]permission{
+		ppb.PatientsService_GetPatient_FullMethodName:              permissionRead,
+		ppb.PatientsService_GetPatientsIDs_FullMethodName:          permissionRead,
+		ppb.PatientsService_BatchGetPatients_FullMethodName:        permissionRead,
+		ppb.PatientsService_SearchPatients_FullMethodName:          permissionRead,
+		ppb.PatientsService_CreatePatient_FullMethodName:           permissionWrite,
+		ppb.PatientsService_UpdatePatient_FullMethodName:           permissionWrite,
+		ppb.PatientsService_PatchPatient_FullMethodName:            permissionWrite,
+		ppb.PatientsService_DeletePatient_FullMethodName:           permissionDelete,
+		ppb.PatientsService_RestorePatient_FullMethodName:          permissionDelete,
+		ppb.PatientsService_ListDeletedPatients_FullMethodName:     permissionRead,
+		ppb.PatientsService_PurgePatient_FullMethodName:            permissionPurge,
+		ppb.PatientsService_GetPatientHistory_FullMethodName:       permissionRead,
+		ppb.PatientsService_ListPatientAccessLog_FullMethodName:    permissionAudit,
+		ppb.PatientsService_AddEmergencyContact_FullMethodName:     permissionWrite,
+		ppb.PatientsService_UpdateEmergencyContact_FullMethodName:  permissionWrite,
+		ppb.PatientsService_RemoveEmergencyContact_FullMethodName:  permissionWrite,
+		ppb.PatientsService_ListEmergencyContacts_FullMethodName:   permissionRead,
+		ppb.PatientsService_FindPotentialDuplicates_FullMethodName: permissionRead,
+		ppb.PatientsService_MergePatients_FullMethodName:           permissionDelete,
+		ppb.PatientsService_AddAllergy_FullMethodName:              permissionWrite,
+		ppb.PatientsService_ResolveAllergy_FullMethodName:          permissionWrite,
+		ppb.PatientsService_ListAllergies_FullMethodName:           permissionRead,
+		ppb.PatientsService_AddCondition_FullMethodName:            permissionWrite,
+		ppb.PatientsService_ResolveCondition_FullMethodName:        permissionWrite,
+		ppb.PatientsService_ListConditions_FullMethodName:          permissionRead,
+		ppb.PatientsService_AddMedication_FullMethodName:           permissionWrite,
+		ppb.PatientsService_UpdateMedication_FullMethodName:        permissionWrite,
+		ppb.PatientsService_RemoveMedication_FullMethodName:        permissionWrite,
+		ppb.PatientsService_ListMedications_FullMethodName:         permissionRead,
+		ppb.PatientsService_ExportPatient_FullMethodName:           permissionRead,
+		ppb.PatientsService_AddInsurance_FullMethodName:            permissionWrite,
+		ppb.PatientsService_UpdateInsurance_FullMethodName:         permissionWrite,
+		ppb.PatientsService_RemoveInsurance_FullMethodName:         permissionWrite,
+	}
+}
+
+// rolePermissions maps role names to the permissions granted to them.
+type rolePermissions map[string]map[permission]struct{}
+
+// parseRolePermissions parses a permission table in the format
+// "<role>=<permission>,<permission>;<role>=<permission>".
+func parseRolePermissions(value string) (rolePermissions, error) {
+	known := knownPermissions()
+	table := make(rolePermissions)
+	for _, entry := range strings.Split(value, rolesSeparator) {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		role, perms, found := strings.Cut(entry, roleAssignment)
+		role = strings.TrimSpace(role)
+		if !found || role == "" {
+			return nil, fmt.Errorf("invalid role permissions entry %q", entry)
+		}
+		if _, exists := table[role]; !exists {
+			table[role] = make(map[permission]struct{})
+		}
+		for _, perm := range strings.Split(perms, permissionsSeparator) {
+			perm = strings.TrimSpace(perm)
+			if perm == "" {
+				continue
+			}
+			if _, exists := known[permission(perm)]; !exists {
+				return nil, fmt.Errorf("unknown permission %q for role %q", perm, role)
+			}
+			table[role][permission(perm)] = struct{}{}
+		}
+	}
+	return table, nil
+}
+
+// allows checks whether any of the roles in claims is granted the given permission.
+func (table rolePermissions) allows(claims ms.Claims, perm permission) bool {
+	for role, perms := range table {
+		if _, granted := perms[perm]; granted && claims.HasRole(role) {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenRequest is implemented by every request of the service, since all of them carry an authentication token.
+type tokenRequest interface {
+	GetToken() string
+}
+
+// actor describes an authenticated user that performs a request.
+type actor struct {
+	// subject is the "sub" claim of the token that identifies the user
+	subject string
+	claims  ms.Claims
+}
+
+// actorKey is a context key under which the actor of a request is stored.
+type actorKey struct{}
+
+// actorFromContext returns the actor stored in ctx by authorize.
+func actorFromContext(ctx context.Context) actor {
+	value, _ := ctx.Value(actorKey{}).(actor)
+	return value
+}
+
+// tokenSubject extracts the "sub" claim from a JWT token.
+// The token has to be verified beforehand, as its signature is not checked.
+func tokenSubject(rawToken string) (string, error) {
+	parts := strings.Split(rawToken, jwtSeparator)
+	if len(parts) != jwtParts {
+		return "", errors.New("malformed token")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("malformed token payload: %w", err)
+	}
+	var tokenClaims struct {
+		Subject string `json:"sub"`
+	}
+	if err = json.Unmarshal(payload, &tokenClaims); err != nil {
+		return "", fmt.Errorf("malformed token claims: %w", err)
+	}
+	return tokenClaims.Subject, nil
+}
+
+// authorize is a GRPC interceptor that verifies the request token and checks that its roles
+// are granted the permission required by the called RPC according to methodPermissions.
+// If authentication is not valid, codes.Unauthenticated is returned.
+// If roles are not sufficient, codes.PermissionDenied is returned.
+// The authenticated actor is stored in the context passed to the handler, see actorFromContext.
+func (server patientsServer) authorize(ctx context.Context, req any, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (any, error) {
+	required, exists := methodPermissions()[info.FullMethod]
+	if !exists {
+		return nil, status.Error(codes.PermissionDenied, permissionDeniedMessage)
+	}
+	request, ok := req.(tokenRequest)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "request doesn't contain a token")
+	}
+	claims, err := server.VerifyToken(ctx, request.GetToken())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if !server.permissions.allows(claims, required) {
+		return nil, status.Error(codes.PermissionDenied, permissionDeniedMessage)
+	}
+	subject, err := tokenSubject(request.GetToken())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return handler(context.WithValue(ctx, actorKey{}, actor{subject: subject, claims: claims}), req)
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// testClaims are claims of a token with the given roles.
+type testClaims []string
+
+func (claims testClaims) HasRole(role string) bool {
+	return claims.GetRoles().Has(role)
+}
+
+func (claims testClaims) GetRoles() sets.Set[string] {
+	return sets.New(claims...)
+}
+
+func TestParseRolePermissions(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		table rolePermissions
+		fails bool
+	}{
+		{
+			name:  "default",
+			value: defaultRolePermissions,
+			table: rolePermissions{"admin": {
+				permissionRead: {}, permissionWrite: {}, permissionDelete: {}, permissionAudit: {},
+			}},
+		},
+		{
+			name:  "several roles",
+			value: "receptionist=patients:read,patients:write;privacy-officer=patients:purge",
+			table: rolePermissions{
+				"receptionist":    {permissionRead: {}, permissionWrite: {}},
+				"privacy-officer": {permissionPurge: {}},
+			},
+		},
+		{
+			name:  "spaces and empty entries",
+			value: " ; nurse = patients:read , ;",
+			table: rolePermissions{"nurse": {permissionRead: {}}},
+		},
+		{
+			name:  "repeated role",
+			value: "nurse=patients:read;nurse=patients:write",
+			table: rolePermissions{"nurse": {permissionRead: {}, permissionWrite: {}}},
+		},
+		{name: "role without permissions", value: "guest=", table: rolePermissions{"guest": {}}},
+		{name: "empty", value: "", table: rolePermissions{}},
+		{name: "missing assignment", value: "admin", fails: true},
+		{name: "missing role", value: "=patients:read", fails: true},
+		{name: "blank role", value: " =patients:read", fails: true},
+		{name: "unknown permission", value: "admin=patients:everything", fails: true},
+		{name: "permission of another service", value: "admin=appointments:read", fails: true},
+		{name: "unknown permission among known ones", value: "admin=patients:read,patients:raed", fails: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			table, err := parseRolePermissions(test.value)
+			if test.fails {
+				if err == nil {
+					t.Errorf("parseRolePermissions(%q) = %v, want an error", test.value, table)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseRolePermissions(%q) failed: %v", test.value, err)
+			}
+			if !reflect.DeepEqual(table, test.table) {
+				t.Errorf("parseRolePermissions(%q) = %v, want %v", test.value, table, test.table)
+			}
+		})
+	}
+}
+
+func TestRolePermissionsAllows(t *testing.T) {
+	defaultTable, err := parseRolePermissions(defaultRolePermissions)
+	if err != nil {
+		t.Fatalf("parseRolePermissions(%q) failed: %v", defaultRolePermissions, err)
+	}
+	table, err := parseRolePermissions("nurse=patients:read;privacy-officer=patients:purge;guest=")
+	if err != nil {
+		t.Fatalf("parseRolePermissions failed: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		table   rolePermissions
+		claims  testClaims
+		perm    permission
+		allowed bool
+	}{
+		{name: "default admin reads", table: defaultTable, claims: testClaims{"admin"}, perm: permissionRead,
+			allowed: true},
+		{name: "default admin writes", table: defaultTable, claims: testClaims{"admin"}, perm: permissionWrite,
+			allowed: true},
+		{name: "default admin deletes", table: defaultTable, claims: testClaims{"admin"}, perm: permissionDelete,
+			allowed: true},
+		{name: "default admin audits", table: defaultTable, claims: testClaims{"admin"}, perm: permissionAudit,
+			allowed: true},
+		{name: "default admin doesn't purge", table: defaultTable, claims: testClaims{"admin"},
+			perm: permissionPurge, allowed: false},
+		{name: "default other role", table: defaultTable, claims: testClaims{"nurse"}, perm: permissionRead,
+			allowed: false},
+		{name: "no roles", table: defaultTable, claims: testClaims{}, perm: permissionRead, allowed: false},
+		{name: "granted", table: table, claims: testClaims{"nurse"}, perm: permissionRead, allowed: true},
+		{name: "not granted", table: table, claims: testClaims{"nurse"}, perm: permissionWrite, allowed: false},
+		{name: "role without permissions", table: table, claims: testClaims{"guest"}, perm: permissionRead,
+			allowed: false},
+		{name: "unknown role", table: table, claims: testClaims{"admin"}, perm: permissionRead, allowed: false},
+		{name: "any of several roles", table: table, claims: testClaims{"guest", "privacy-officer"},
+			perm: permissionPurge, allowed: true},
+		{name: "empty table", table: rolePermissions{}, claims: testClaims{"admin"}, perm: permissionRead,
+			allowed: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if allowed := test.table.allows(test.claims, test.perm); allowed != test.allowed {
+				t.Errorf("allows(%v, %q) = %v, want %v", test.claims, test.perm, allowed, test.allowed)
+			}
+		})
+	}
+}
+
+func TestTokenSubject(t *testing.T) {
+	encode := base64.RawURLEncoding.EncodeToString
+	header := encode([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	tests := []struct {
+		name    string
+		token   string
+		subject string
+		fails   bool
+	}{
+		{name: "valid", token: header + "." + encode([]byte(`{"sub":"user-1","roles":["admin"]}`)) + ".signature",
+			subject: "user-1"},
+		{name: "without subject", token: header + "." + encode([]byte(`{"roles":["admin"]}`)) + ".signature",
+			subject: ""},
+		{name: "empty", token: "", fails: true},
+		{name: "two parts", token: header + "." + encode([]byte(`{"sub":"user-1"}`)), fails: true},
+		{name: "four parts", token: header + "." + encode([]byte(`{"sub":"user-1"}`)) + ".signature.extra",
+			fails: true},
+		{name: "padded payload", token: header + "." + base64.URLEncoding.EncodeToString([]byte(`{"sub":"u"}`)) +
+			".signature", fails: true},
+		{name: "payload not base64", token: header + ".not base64!.signature", fails: true},
+		{name: "payload not JSON", token: header + "." + encode([]byte("user-1")) + ".signature", fails: true},
+		{name: "subject not a string", token: header + "." + encode([]byte(`{"sub":1}`)) + ".signature",
+			fails: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			subject, err := tokenSubject(test.token)
+			if test.fails {
+				if err == nil {
+					t.Errorf("tokenSubject(%q) = %q, want an error", test.token, subject)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("tokenSubject(%q) failed: %v", test.token, err)
+			}
+			if subject != test.subject {
+				t.Errorf("tokenSubject(%q) = %q, want %q", test.token, subject, test.subject)
+			}
+		})
+	}
+}
@@ -0,0 +1,457 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	ppb "github.com/TekClinic/Patients-MicroService/patients_protobuf"
+	sf "github.com/sa-/slicefunk"
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	allergyNotFoundMessage   = "allergy is not found"
+	conditionNotFoundMessage = "condition is not found"
+
+	// maxClinicalRecords is the maximum number of allergies, conditions or medications of a patient.
+	maxClinicalRecords = 100
+
+	// severityTag is the validation tag of severities of allergies and conditions, which have to be known values.
+	severityTag = "severity"
+
+	// recordsLimitRule is the rule of records added to a patient that already has the maximum number of them.
+	recordsLimitRule = "records_limit"
+)
+
+// AddAllergy adds an active allergy to a patient with the given id.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:write permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If the allergy is not valid or the patient already has the maximum number of allergies,
+// codes.InvalidArgument is returned.
+// If a patient with a given id doesn't exist, codes.NotFound is returned.
+func (server patientsServer) AddAllergy(ctx context.Context, req *ppb.AddAllergyRequest) (
+	*ppb.AddAllergyResponse, error) {
+	onsetDate, err := parseOptionalDate(req.GetAllergy().GetOnsetDate())
+	if err != nil {
+		return nil, fieldViolationError("allergy.onset_date", fmt.Sprintf("failed to parse onset date: %v", err))
+	}
+	allergy := &Allergy{
+		Code:      req.GetAllergy().GetCode(),
+		Severity:  req.GetAllergy().GetSeverity(),
+		Reaction:  req.GetAllergy().GetReaction(),
+		OnsetDate: onsetDate,
+		Status:    ppb.Patient_ACTIVE,
+	}
+	if err = server.validate.Struct(allergy); err != nil {
+		return nil, server.validationError(ctx, "allergy", err)
+	}
+
+	err = server.changePatientRecords(ctx, req.GetPatientId(), func(patient *Patient) error {
+		if limitErr := server.checkRecordsLimit(ctx, "allergy", "allergies", len(patient.Allergies),
+			maxClinicalRecords); limitErr != nil {
+			return limitErr
+		}
+		patient.Allergies = append(patient.Allergies, allergy)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ppb.AddAllergyResponse{Id: allergy.ID}, nil
+}
+
+// ResolveAllergy marks an active allergy of a patient with the given id as resolved at the given date,
+// or today if the date is not given. Resolved allergies are kept in the patient record.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:write permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If the resolved date is not valid or is before the onset date, codes.InvalidArgument is returned.
+// If a patient or its allergy with given ids don't exist, codes.NotFound is returned.
+// If the allergy is already resolved, codes.FailedPrecondition is returned.
+func (server patientsServer) ResolveAllergy(ctx context.Context, req *ppb.ResolveAllergyRequest) (
+	*ppb.ResolveAllergyResponse, error) {
+	resolvedDate, err := resolvedDateFromRequest(req.GetResolvedDate())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.changePatientRecords(ctx, req.GetPatientId(), func(patient *Patient) error {
+		for _, allergy := range patient.Allergies {
+			if allergy.ID == req.GetId() {
+				return resolveClinicalRecord(&allergy.Status, &allergy.ResolvedDate, allergy.OnsetDate, resolvedDate)
+			}
+		}
+		return status.Error(codes.NotFound, allergyNotFoundMessage)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ppb.ResolveAllergyResponse{}, nil
+}
+
+// ListAllergies returns active allergies of a patient with the given id, and resolved ones if requested.
+// If the patient was merged into another patient, records of the other patient are returned, like in GetPatient.
+// Access to the patient is recorded in the access log.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:read permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If a patient with a given id doesn't exist, codes.NotFound is returned.
+func (server patientsServer) ListAllergies(ctx context.Context, req *ppb.ListAllergiesRequest) (
+	*ppb.ListAllergiesResponse, error) {
+	var filter func(query *bun.SelectQuery) *bun.SelectQuery
+	if !req.GetIncludeResolved() {
+		filter = activeClinicalRecords
+	}
+	var allergies []*Allergy
+	patientID, err := server.fetchClinicalRecords(ctx, req.GetPatientId(), &allergies, filter)
+	if err != nil {
+		return nil, err
+	}
+	if err = server.logAccess(ctx, ppb.PatientsService_ListAllergies_FullMethodName, patientID); err != nil {
+		return nil, err
+	}
+	return &ppb.ListAllergiesResponse{
+		Results: sf.Map(allergies, func(allergy *Allergy) *ppb.Patient_Allergy { return allergy.toGRPC() }),
+	}, nil
+}
+
+// AddCondition adds an active chronic condition to a patient with the given id.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:write permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If the condition is not valid or the patient already has the maximum number of conditions,
+// codes.InvalidArgument is returned.
+// If a patient with a given id doesn't exist, codes.NotFound is returned.
+func (server patientsServer) AddCondition(ctx context.Context, req *ppb.AddConditionRequest) (
+	*ppb.AddConditionResponse, error) {
+	onsetDate, err := parseOptionalDate(req.GetCondition().GetOnsetDate())
+	if err != nil {
+		return nil, fieldViolationError("condition.onset_date", fmt.Sprintf("failed to parse onset date: %v", err))
+	}
+	condition := &Condition{
+		Code:      req.GetCondition().GetCode(),
+		Severity:  req.GetCondition().GetSeverity(),
+		OnsetDate: onsetDate,
+		Status:    ppb.Patient_ACTIVE,
+	}
+	if err = server.validate.Struct(condition); err != nil {
+		return nil, server.validationError(ctx, "condition", err)
+	}
+
+	err = server.changePatientRecords(ctx, req.GetPatientId(), func(patient *Patient) error {
+		if limitErr := server.checkRecordsLimit(ctx, "condition", "conditions", len(patient.Conditions),
+			maxClinicalRecords); limitErr != nil {
+			return limitErr
+		}
+		patient.Conditions = append(patient.Conditions, condition)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ppb.AddConditionResponse{Id: condition.ID}, nil
+}
+
+// ResolveCondition marks an active condition of a patient with the given id as resolved at the given date,
+// or today if the date is not given. Resolved conditions are kept in the patient record.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:write permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If the resolved date is not valid or is before the onset date, codes.InvalidArgument is returned.
+// If a patient or its condition with given ids don't exist, codes.NotFound is returned.
+// If the condition is already resolved, codes.FailedPrecondition is returned.
+func (server patientsServer) ResolveCondition(ctx context.Context, req *ppb.ResolveConditionRequest) (
+	*ppb.ResolveConditionResponse, error) {
+	resolvedDate, err := resolvedDateFromRequest(req.GetResolvedDate())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.changePatientRecords(ctx, req.GetPatientId(), func(patient *Patient) error {
+		for _, condition := range patient.Conditions {
+			if condition.ID == req.GetId() {
+				return resolveClinicalRecord(&condition.Status, &condition.ResolvedDate, condition.OnsetDate,
+					resolvedDate)
+			}
+		}
+		return status.Error(codes.NotFound, conditionNotFoundMessage)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ppb.ResolveConditionResponse{}, nil
+}
+
+// ListConditions returns active conditions of a patient with the given id, and resolved ones if requested.
+// If the patient was merged into another patient, records of the other patient are returned, like in GetPatient.
+// Access to the patient is recorded in the access log.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:read permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If a patient with a given id doesn't exist, codes.NotFound is returned.
+func (server patientsServer) ListConditions(ctx context.Context, req *ppb.ListConditionsRequest) (
+	*ppb.ListConditionsResponse, error) {
+	var filter func(query *bun.SelectQuery) *bun.SelectQuery
+	if !req.GetIncludeResolved() {
+		filter = activeClinicalRecords
+	}
+	var conditions []*Condition
+	patientID, err := server.fetchClinicalRecords(ctx, req.GetPatientId(), &conditions, filter)
+	if err != nil {
+		return nil, err
+	}
+	if err = server.logAccess(ctx, ppb.PatientsService_ListConditions_FullMethodName, patientID); err != nil {
+		return nil, err
+	}
+	return &ppb.ListConditionsResponse{
+		Results: sf.Map(conditions, func(condition *Condition) *ppb.Patient_Condition { return condition.toGRPC() }),
+	}, nil
+}
+
+// resolvedDateFromRequest returns the resolved date of a request, or today if the request has no date.
+// If the date is not valid, codes.InvalidArgument is returned.
+func resolvedDateFromRequest(value string) (time.Time, error) {
+	if value == "" {
+		return time.Now().UTC().Truncate(hoursInDay * time.Hour), nil
+	}
+	resolvedDate, err := time.Parse(birthDateFormat, value)
+	if err != nil {
+		return time.Time{}, fieldViolationError("resolved_date", fmt.Sprintf("failed to parse resolved date: %v", err))
+	}
+	return resolvedDate, nil
+}
+
+// resolveClinicalRecord marks an allergy or a condition with the given status and dates as resolved at resolvedDate.
+// If the record is already resolved, codes.FailedPrecondition is returned.
+// If resolvedDate is before the onset date, codes.InvalidArgument is returned.
+func resolveClinicalRecord(recordStatus *ppb.Patient_ClinicalStatus, recordResolvedDate *time.Time,
+	onsetDate time.Time, resolvedDate time.Time) error {
+	if *recordStatus == ppb.Patient_RESOLVED {
+		return status.Error(codes.FailedPrecondition, "record is already resolved")
+	}
+	if resolvedDate.Before(onsetDate) {
+		return fieldViolationError("resolved_date", "resolved date has to be on or after the onset date")
+	}
+	*recordStatus = ppb.Patient_RESOLVED
+	*recordResolvedDate = resolvedDate
+	return nil
+}
+
+// activeClinicalRecords filters a query of allergies or conditions to active ones.
+func activeClinicalRecords(query *bun.SelectQuery) *bun.SelectQuery {
+	return query.Where("status = ?", int32(ppb.Patient_ACTIVE))
+}
+
+// fetchClinicalRecords fetches allergies, conditions or medications of a patient with the given id into records,
+// ordered by their ids, and returns the id of the patient whose records were fetched.
+// Like GetPatient, if the patient was merged into another patient, records of the other patient are fetched.
+// If filter is set, only records matching it are fetched.
+// If a patient with a given id doesn't exist, codes.NotFound is returned.
+func (server patientsServer) fetchClinicalRecords(ctx context.Context, patientID int32, records interface{},
+	filter func(query *bun.SelectQuery) *bun.SelectQuery) (int32, error) {
+	var patient Patient
+	err := server.db.NewSelect().
+		Model(&patient).
+		Column("id", "merged_into").
+		Where("id = ?", patientID).
+		WhereAllWithDeleted().
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, status.Error(codes.NotFound, "patient is not found")
+	}
+	if err != nil {
+		return 0, status.Error(codes.Internal, fmt.Errorf("failed to fetch a patient: %w", err).Error())
+	}
+	// merges are flattened, so the patient it was merged into is never merged itself
+	if patient.MergedInto != 0 {
+		patientID = patient.MergedInto
+	}
+
+	query := server.db.NewSelect().
+		Model(records).
+		Where("patient_id = ?", patientID).
+		Order("id")
+	if filter != nil {
+		query = filter(query)
+	}
+	if err = query.Scan(ctx); err != nil {
+		return 0, status.Error(codes.Internal, fmt.Errorf("failed to fetch clinical records: %w", err).Error())
+	}
+	return patientID, nil
+}
+
+// changePatientRecords locks a patient with the given id, lets change modify its allergies, conditions,
+// medications and insurances, saves the modified records and saves the patient as a new version of the patient.
+// Change receives copies of the current records, so it may modify them freely.
+// The patient isn't validated as a whole, so fields stored before their rules changed don't block changes
+// of records. Callers validate the records they change and check limits by checkRecordsLimit instead.
+// Records without an id are inserted, changed records are updated in place and unchanged records aren't written.
+// Medications and insurances that change removes are deleted.
+func (server patientsServer) changePatientRecords(ctx context.Context, patientID int32,
+	change func(patient *Patient) error) error {
+	err := server.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		previous, txErr := lockPatient(ctx, tx, patientID)
+		if txErr != nil {
+			return txErr
+		}
+		if txErr = fetchMedications(ctx, tx, previous); txErr != nil {
+			return txErr
+		}
+
+		patient := *previous
+		patient.Allergies = sf.Map(previous.Allergies, func(allergy *Allergy) *Allergy {
+			allergyCopy := *allergy
+			return &allergyCopy
+		})
+		patient.Conditions = sf.Map(previous.Conditions, func(condition *Condition) *Condition {
+			conditionCopy := *condition
+			return &conditionCopy
+		})
+		patient.Medications = sf.Map(previous.Medications, func(medication *Medication) *Medication {
+			medicationCopy := *medication
+			return &medicationCopy
+		})
+		patient.Insurances = sf.Map(previous.Insurances, func(insurance *Insurance) *Insurance {
+			insuranceCopy := *insurance
+			return &insuranceCopy
+		})
+		if txErr = change(&patient); txErr != nil {
+			return txErr
+		}
+
+		if txErr = savePatientRecords(ctx, tx, previous, &patient); txErr != nil {
+			return txErr
+		}
+
+		patient.Version++
+		if _, txErr = tx.NewUpdate().Model(&patient).Column("version").WherePK().Exec(ctx); txErr != nil {
+			return status.Error(codes.Internal, fmt.Errorf("failed to update a patient: %w", txErr).Error())
+		}
+		return recordRevision(ctx, tx, patient.ID, ppb.PatientRevision_UPDATE, previous, &patient)
+	})
+	if err != nil {
+		return toStatusError(err)
+	}
+	return nil
+}
+
+// checkRecordsLimit checks that a record at the given path of the request can be added to count records
+// of a patient at field without exceeding the limit.
+// If the patient already has limit records, codes.InvalidArgument is returned.
+func (server patientsServer) checkRecordsLimit(ctx context.Context, path string, field string, count int,
+	limit int) error {
+	if count >= limit {
+		return server.ruleViolationError(ctx, path, field, recordsLimitRule, strconv.Itoa(limit))
+	}
+	return nil
+}
+
+// savePatientRecords saves allergies, conditions, medications and insurances of a patient that are new
+// or differ from the previous records of the patient, and deletes medications and insurances that
+// the patient doesn't have anymore. Unchanged records aren't written, so changing a single record
+// takes a single statement.
+func savePatientRecords(ctx context.Context, tx bun.Tx, previous *Patient, patient *Patient) error {
+	allergyID := func(allergy *Allergy) int32 { return allergy.ID }
+	for _, allergy := range changedRecords(previous.Allergies, patient.Allergies, allergyID) {
+		allergy.PatientID = patient.ID
+		if err := savePatientRecord(ctx, tx, allergy, allergy.ID); err != nil {
+			return err
+		}
+	}
+	conditionID := func(condition *Condition) int32 { return condition.ID }
+	for _, condition := range changedRecords(previous.Conditions, patient.Conditions, conditionID) {
+		condition.PatientID = patient.ID
+		if err := savePatientRecord(ctx, tx, condition, condition.ID); err != nil {
+			return err
+		}
+	}
+
+	medicationID := func(medication *Medication) int32 { return medication.ID }
+	removedMedications := removedRecordIDs(previous.Medications, patient.Medications, medicationID)
+	if err := deletePatientRecords(ctx, tx, (*Medication)(nil), removedMedications); err != nil {
+		return err
+	}
+	for _, medication := range changedRecords(previous.Medications, patient.Medications, medicationID) {
+		medication.PatientID = patient.ID
+		if err := savePatientRecord(ctx, tx, medication, medication.ID); err != nil {
+			return err
+		}
+	}
+	insuranceID := func(insurance *Insurance) int32 { return insurance.ID }
+	removedInsurances := removedRecordIDs(previous.Insurances, patient.Insurances, insuranceID)
+	if err := deletePatientRecords(ctx, tx, (*Insurance)(nil), removedInsurances); err != nil {
+		return err
+	}
+	for _, insurance := range changedRecords(previous.Insurances, patient.Insurances, insuranceID) {
+		insurance.PatientID = patient.ID
+		if err := savePatientRecord(ctx, tx, insurance, insurance.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// changedRecords returns records without a stored id and records that differ from the previous record
+// with the same id.
+func changedRecords[T comparable](previous []*T, records []*T, id func(record *T) int32) []*T {
+	stored := make(map[int32]*T, len(previous))
+	for _, record := range previous {
+		stored[id(record)] = record
+	}
+	return sf.Filter(records, func(record *T) bool {
+		previousRecord, exists := stored[id(record)]
+		return !exists || *previousRecord != *record
+	})
+}
+
+// removedRecordIDs returns ids of previous records that are missing from records.
+func removedRecordIDs[T any](previous []*T, records []*T, id func(record *T) int32) []int32 {
+	kept := make(map[int32]struct{}, len(records))
+	for _, record := range records {
+		kept[id(record)] = struct{}{}
+	}
+	return sf.Map(sf.Filter(previous, func(record *T) bool {
+		_, isKept := kept[id(record)]
+		return !isKept
+	}), id)
+}
+
+// deletePatientRecords deletes records of the given model with the given ids.
+func deletePatientRecords(ctx context.Context, tx bun.Tx, model interface{}, ids []int32) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if _, err := tx.NewDelete().Model(model).Where("id IN (?)", bun.In(ids)).Exec(ctx); err != nil {
+		return status.Error(codes.Internal, fmt.Errorf("failed to delete removed records: %w", err).Error())
+	}
+	return nil
+}
+
+// deleteRemovedRecords deletes records of the given model that belong to a patient with the given id,
+// except for the records with kept ids.
+func deleteRemovedRecords(ctx context.Context, tx bun.Tx, model interface{}, patientID int32, kept []int32) error {
+	query := tx.NewDelete().Model(model).Where("patient_id = ?", patientID)
+	if len(kept) > 0 {
+		query = query.Where("id NOT IN (?)", bun.In(kept))
+	}
+	if _, err := query.Exec(ctx); err != nil {
+		return status.Error(codes.Internal, fmt.Errorf("failed to delete removed records: %w", err).Error())
+	}
+	return nil
+}
+
+// savePatientRecord inserts a record of a patient, e.g. an allergy, with the given id if the id is not set yet,
+// otherwise it updates the stored record.
+func savePatientRecord(ctx context.Context, tx bun.Tx, record interface{}, id int32) error {
+	var err error
+	if id == 0 {
+		_, err = tx.NewInsert().Model(record).Exec(ctx)
+	} else {
+		_, err = tx.NewUpdate().Model(record).WherePK().Exec(ctx)
+	}
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Errorf("failed to save a patient record: %w", err).Error())
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	ppb "github.com/TekClinic/Patients-MicroService/patients_protobuf"
+	sf "github.com/sa-/slicefunk"
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	emergencyContactNotFoundMessage = "emergency contact is not found"
+
+	// maxEmergencyContacts is the maximum number of emergency contacts of a patient.
+	maxEmergencyContacts = 10
+)
+
+// AddEmergencyContact adds an emergency contact to a patient with the given id.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:write permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If the contact is not valid or the patient already has the maximum number of contacts,
+// codes.InvalidArgument is returned.
+// If a patient with a given id doesn't exist, codes.NotFound is returned.
+func (server patientsServer) AddEmergencyContact(ctx context.Context, req *ppb.AddEmergencyContactRequest) (
+	*ppb.AddEmergencyContactResponse, error) {
+	contact := emergencyContactFromGRPC(req.GetContact())
+	contact.ID = 0
+	if err := server.validate.Struct(contact); err != nil {
+		return nil, server.validationError(ctx, "contact", err)
+	}
+
+	err := server.changeEmergencyContacts(ctx, req.GetPatientId(),
+		func(contacts []*EmergencyContact) ([]*EmergencyContact, error) {
+			if limitErr := server.checkRecordsLimit(ctx, "contact", emergencyContactsField, len(contacts),
+				maxEmergencyContacts); limitErr != nil {
+				return nil, limitErr
+			}
+			return append(contacts, contact), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &ppb.AddEmergencyContactResponse{Id: contact.ID}, nil
+}
+
+// UpdateEmergencyContact updates an emergency contact of a patient with the given id.
+// The contact is identified by its id, which is kept unchanged.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:write permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If the contact is not valid, codes.InvalidArgument is returned.
+// If a patient or its contact with given ids don't exist, codes.NotFound is returned.
+func (server patientsServer) UpdateEmergencyContact(ctx context.Context, req *ppb.UpdateEmergencyContactRequest) (
+	*ppb.UpdateEmergencyContactResponse, error) {
+	updated := emergencyContactFromGRPC(req.GetContact())
+	if updated.ID == 0 {
+		return nil, fieldViolationError("contact.id", "emergency contact ID is required")
+	}
+	if err := server.validate.Struct(updated); err != nil {
+		return nil, server.validationError(ctx, "contact", err)
+	}
+
+	err := server.changeEmergencyContacts(ctx, req.GetPatientId(),
+		func(contacts []*EmergencyContact) ([]*EmergencyContact, error) {
+			for i, contact := range contacts {
+				if contact.ID == updated.ID {
+					contacts[i] = updated
+					return contacts, nil
+				}
+			}
+			return nil, status.Error(codes.NotFound, emergencyContactNotFoundMessage)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &ppb.UpdateEmergencyContactResponse{Id: updated.ID}, nil
+}
+
+// RemoveEmergencyContact removes an emergency contact with the given id from a patient.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:write permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If a patient or its contact with given ids don't exist, codes.NotFound is returned.
+func (server patientsServer) RemoveEmergencyContact(ctx context.Context, req *ppb.RemoveEmergencyContactRequest) (
+	*ppb.RemoveEmergencyContactResponse, error) {
+	err := server.changeEmergencyContacts(ctx, req.GetPatientId(),
+		func(contacts []*EmergencyContact) ([]*EmergencyContact, error) {
+			remaining := sf.Filter(contacts, func(contact *EmergencyContact) bool { return contact.ID != req.GetId() })
+			if len(remaining) == len(contacts) {
+				return nil, status.Error(codes.NotFound, emergencyContactNotFoundMessage)
+			}
+			return remaining, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &ppb.RemoveEmergencyContactResponse{}, nil
+}
+
+// ListEmergencyContacts returns all emergency contacts of a patient with the given id.
+// Access to the patient is recorded in the access log.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:read permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If a patient with a given id doesn't exist, codes.NotFound is returned.
+func (server patientsServer) ListEmergencyContacts(ctx context.Context, req *ppb.ListEmergencyContactsRequest) (
+	*ppb.ListEmergencyContactsResponse, error) {
+	exists, err := server.db.NewSelect().
+		Model((*Patient)(nil)).
+		Where("id = ?", req.GetPatientId()).
+		WhereAllWithDeleted().
+		Exists(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to fetch a patient: %w", err).Error())
+	}
+	if !exists {
+		return nil, status.Error(codes.NotFound, "patient is not found")
+	}
+
+	var contacts []*EmergencyContact
+	err = server.db.NewSelect().
+		Model(&contacts).
+		Where("patient_id = ?", req.GetPatientId()).
+		Order("id").
+		Scan(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to fetch emergency contacts: %w", err).Error())
+	}
+	err = server.logAccess(ctx, ppb.PatientsService_ListEmergencyContacts_FullMethodName, req.GetPatientId())
+	if err != nil {
+		return nil, err
+	}
+	return &ppb.ListEmergencyContactsResponse{
+		Results: sf.Map(contacts, func(contact *EmergencyContact) *ppb.Patient_EmergencyContact {
+			return contact.toGRPC()
+		}),
+	}, nil
+}
+
+// changeEmergencyContacts locks a patient with the given id, replaces its emergency contacts with the result
+// of change and saves it as a new version of the patient.
+// Change receives copies of the current contacts, so it may modify them freely.
+// Like changePatientRecords, the patient isn't validated as a whole, callers validate the contacts they change.
+func (server patientsServer) changeEmergencyContacts(ctx context.Context, patientID int32,
+	change func(contacts []*EmergencyContact) ([]*EmergencyContact, error)) error {
+	err := server.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		previous, txErr := lockPatient(ctx, tx, patientID)
+		if txErr != nil {
+			return txErr
+		}
+
+		patient := *previous
+		patient.EmergencyContacts, txErr = change(sf.Map(previous.EmergencyContacts,
+			func(contact *EmergencyContact) *EmergencyContact {
+				contactCopy := *contact
+				return &contactCopy
+			}))
+		if txErr != nil {
+			return txErr
+		}
+
+		patient.Version++
+		return savePatient(ctx, tx, "", previous, &patient, true, ppb.PatientRevision_UPDATE)
+	})
+	if err != nil {
+		return toStatusError(err)
+	}
+	return nil
+}
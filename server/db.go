@@ -3,14 +3,23 @@ package main
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	ppb "github.com/TekClinic/Patients-MicroService/patients_protobuf"
 	sf "github.com/sa-/slicefunk"
 	"github.com/uptrace/bun"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-const birthDateFormat = "2006-01-02"
+const (
+	birthDateFormat = "2006-01-02"
+
+	// pgUniqueViolation is the Postgres error code of unique constraint violations.
+	pgUniqueViolation = "23505"
+	// personalIDIndex is the name of the unique index of personal IDs of patients that are not deleted.
+	personalIDIndex = "patients_personal_id_key"
+)
 
 // PersonalID defines a schema of personal ids.
 type PersonalID struct {
@@ -18,6 +27,18 @@ type PersonalID struct {
 	Type string
 }
 
+// Address defines a schema of patient addresses.
+// Street is required with a house number or an apartment, and city is required with a street or a postal code.
+type Address struct {
+	Street      string `validate:"max=100,required_with=HouseNumber Apartment"`
+	HouseNumber string `validate:"max=20"`
+	Apartment   string `validate:"max=20"`
+	City        string `validate:"max=100,required_with=Street PostalCode"`
+	PostalCode  string `validate:"max=20"`
+	Country     string `validate:"omitempty,iso3166_1_alpha2"`
+	Directions  string `validate:"max=500"`
+}
+
 // EmergencyContact defines a schema of emergency contacts.
 type EmergencyContact struct {
 	ID        int32  `bun:",pk,autoincrement"`
@@ -27,6 +48,70 @@ type EmergencyContact struct {
 	PatientID int32
 }
 
+// Guardian defines a schema of legal guardians of patients, e.g. parents of minors.
+// A guardian is either a person described by its name or another patient linked by its id.
+// LinkedName and LinkedPhone are not stored, they are resolved from the linked patient when it is read.
+type Guardian struct {
+	ID              int32  `bun:",pk,autoincrement"`
+	Name            string `validate:"required_without=LinkedPatientID,max=100"`
+	Relationship    string `validate:"required,max=100"`
+	Phone           string `validate:"omitempty,e164"`
+	LinkedPatientID int32  `bun:",nullzero"`
+	LinkedName      string `bun:"-"`
+	LinkedPhone     string `bun:"-"`
+	PatientID       int32
+}
+
+// Allergy defines a schema of patient allergies and intolerances.
+type Allergy struct {
+	ID           int32                      `bun:",pk,autoincrement"`
+	Code         string                     `validate:"required,max=100"`
+	Severity     ppb.Patient_Severity       `validate:"severity"`
+	Reaction     string                     `validate:"max=200"`
+	OnsetDate    time.Time                  `bun:",nullzero"`
+	Status       ppb.Patient_ClinicalStatus ``
+	ResolvedDate time.Time                  `bun:",nullzero"`
+	PatientID    int32
+}
+
+// Condition defines a schema of patient chronic conditions.
+type Condition struct {
+	ID           int32                      `bun:",pk,autoincrement"`
+	Code         string                     `validate:"required,max=100"`
+	Severity     ppb.Patient_Severity       `validate:"severity"`
+	OnsetDate    time.Time                  `bun:",nullzero"`
+	Status       ppb.Patient_ClinicalStatus ``
+	ResolvedDate time.Time                  `bun:",nullzero"`
+	PatientID    int32
+}
+
+// Medication defines a schema of medications taken by patients.
+// Route is one of medicationRoutes, the stop date can't be before the start date.
+type Medication struct {
+	ID         int32     `bun:",pk,autoincrement"`
+	Name       string    `validate:"required,max=100"`
+	Dose       string    `validate:"required,max=50"`
+	Frequency  string    `validate:"required,max=50"`
+	Route      string    `validate:"required,medication_route"`
+	StartDate  time.Time `bun:",nullzero"`
+	StopDate   time.Time `bun:",nullzero" validate:"omitempty,gtefield=StartDate"`
+	Prescriber string    `validate:"max=100"`
+	Active     bool
+	PatientID  int32
+}
+
+// Insurance defines a schema of health insurance memberships of patients, e.g. in an HMO (kupat holim).
+// Provider is one of insuranceProviders, and the member number is validated according to the provider.
+type Insurance struct {
+	ID           int32     `bun:",pk,autoincrement"`
+	Provider     string    `validate:"required"`
+	MemberNumber string    `validate:"required"`
+	Plan         string    `validate:"max=100"`
+	ValidFrom    time.Time `bun:",nullzero"`
+	ValidTo      time.Time `bun:",nullzero" validate:"omitempty,gtefield=ValidFrom"`
+	PatientID    int32
+}
+
 // Patient defines a schema of patients.
 type Patient struct {
 	ID                int32               `bun:",pk,autoincrement" `
@@ -35,16 +120,83 @@ type Patient struct {
 	PersonalID        PersonalID          `bun:"embed:personal_id_" validate:"required"`
 	Gender            ppb.Patient_Gender  ``
 	PhoneNumber       string              `validate:"omitempty,e164"`
+	Address           Address             `bun:"embed:address_"`
 	Languages         []string            `bun:",array" validate:"max=10,dive,max=100"`
-	NeedsTranslator   string              ``
+	NeedsTranslator   bool                ``
 	BirthDate         time.Time           `validate:"required"`
 	ReferredBy        string              `validate:"max=100"`
 	EmergencyContacts []*EmergencyContact `bun:"rel:has-many,join:id=patient_id" validate:"max=10,dive"`
+	Guardians         []*Guardian         `bun:"rel:has-many,join:id=patient_id" validate:"max=5,dive"`
+	Allergies         []*Allergy          `bun:"rel:has-many,join:id=patient_id" validate:"max=100,dive"`
+	Conditions        []*Condition        `bun:"rel:has-many,join:id=patient_id" validate:"max=100,dive"`
+	Medications       []*Medication       `bun:"rel:has-many,join:id=patient_id" validate:"max=100,dive"`
+	Insurances        []*Insurance        `bun:"rel:has-many,join:id=patient_id" validate:"max=10,dive"`
 	SpecialNote       string              `validate:"max=500"`
+	Version           int32               `bun:",nullzero,notnull,default:1"`
+	MergedInto        int32               `bun:",nullzero"`
 	CreatedAt         time.Time           `bun:",nullzero,notnull,default:current_timestamp"`
 	DeletedAt         time.Time           `bun:",soft_delete,nullzero"`
 }
 
+// PatientSummary defines a lightweight projection of a patient returned by patient searches.
+type PatientSummary struct {
+	ID          int32
+	Name        string
+	PersonalID  PersonalID `bun:"embed:personal_id_"`
+	BirthDate   time.Time
+	PhoneNumber string
+	// Highlight is the matched text of the patient with search terms highlighted, empty if not requested
+	Highlight string
+}
+
+// PurgeRecord defines a schema of records about permanently erased patients.
+// It intentionally contains no personal information about the purged patient.
+type PurgeRecord struct {
+	ID                     int32 `bun:",pk,autoincrement"`
+	PatientID              int32
+	Reason                 string
+	EmergencyContactsCount int
+	PurgedAt               time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+}
+
+// FieldChange defines a schema of a single field change inside a patient revision.
+type FieldChange struct {
+	Field    string `json:"field"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+}
+
+// PatientRevision defines a schema of immutable patient revisions, used as an audit trail of patient changes.
+type PatientRevision struct {
+	ID        int64 `bun:",pk,autoincrement"`
+	PatientID int32
+	Action    ppb.PatientRevision_Action
+	Actor     string
+	Changes   []FieldChange `bun:"type:jsonb"`
+	CreatedAt time.Time     `bun:",nullzero,notnull,default:current_timestamp"`
+}
+
+// AccessLogEntry defines a schema of patient read-access log entries.
+type AccessLogEntry struct {
+	ID            int64 `bun:",pk,autoincrement"`
+	PatientID     int32
+	Subject       string
+	Roles         []string `bun:",array"`
+	Method        string
+	ClientAddress string
+	AccessedAt    time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+}
+
+// IdempotencyKey defines a schema of idempotency keys of CreatePatient requests, used to recognize retries.
+// Keys are scoped by the subject that sent them, so different clients can't collide.
+type IdempotencyKey struct {
+	Key         string `bun:",pk"`
+	Subject     string `bun:",pk"`
+	RequestHash string
+	PatientID   int32     `bun:",nullzero"`
+	CreatedAt   time.Time `bun:",nullzero,notnull,default:current_timestamp"`
+}
+
 // toGRPC returns a GRPC version of PersonalID.
 func (personalId PersonalID) toGRPC() *ppb.Patient_PersonalID {
 	return &ppb.Patient_PersonalID{
@@ -53,17 +205,137 @@ func (personalId PersonalID) toGRPC() *ppb.Patient_PersonalID {
 	}
 }
 
-// personalIDFromGRPC returns a PersonalID from a GRPC version.
+// personalIDFromGRPC returns a normalized PersonalID from a GRPC version.
 func personalIDFromGRPC(personalID *ppb.Patient_PersonalID) PersonalID {
 	return PersonalID{
 		ID:   personalID.GetId(),
 		Type: personalID.GetType(),
+	}.normalized()
+}
+
+// toGRPC returns a GRPC version of Address.
+func (address Address) toGRPC() *ppb.Patient_Address {
+	return &ppb.Patient_Address{
+		Street:      address.Street,
+		HouseNumber: address.HouseNumber,
+		Apartment:   address.Apartment,
+		City:        address.City,
+		PostalCode:  address.PostalCode,
+		Country:     address.Country,
+		Directions:  address.Directions,
+	}
+}
+
+// addressFromGRPC returns an Address from a GRPC version.
+// Country codes are case-insensitive and are stored in upper case.
+func addressFromGRPC(address *ppb.Patient_Address) Address {
+	return Address{
+		Street:      strings.TrimSpace(address.GetStreet()),
+		HouseNumber: strings.TrimSpace(address.GetHouseNumber()),
+		Apartment:   strings.TrimSpace(address.GetApartment()),
+		City:        strings.TrimSpace(address.GetCity()),
+		PostalCode:  strings.TrimSpace(address.GetPostalCode()),
+		Country:     strings.ToUpper(strings.TrimSpace(address.GetCountry())),
+		Directions:  strings.TrimSpace(address.GetDirections()),
+	}
+}
+
+// toGRPC returns a GRPC version of Allergy.
+func (allergy Allergy) toGRPC() *ppb.Patient_Allergy {
+	return &ppb.Patient_Allergy{
+		Id:           allergy.ID,
+		Code:         allergy.Code,
+		Severity:     allergy.Severity,
+		Reaction:     allergy.Reaction,
+		OnsetDate:    formatOptionalDate(allergy.OnsetDate),
+		Status:       allergy.Status,
+		ResolvedDate: formatOptionalDate(allergy.ResolvedDate),
+	}
+}
+
+// toGRPC returns a GRPC version of Condition.
+func (condition Condition) toGRPC() *ppb.Patient_Condition {
+	return &ppb.Patient_Condition{
+		Id:           condition.ID,
+		Code:         condition.Code,
+		Severity:     condition.Severity,
+		OnsetDate:    formatOptionalDate(condition.OnsetDate),
+		Status:       condition.Status,
+		ResolvedDate: formatOptionalDate(condition.ResolvedDate),
 	}
 }
 
+// toGRPC returns a GRPC version of Medication.
+func (medication Medication) toGRPC() *ppb.Patient_Medication {
+	return &ppb.Patient_Medication{
+		Id:         medication.ID,
+		Name:       medication.Name,
+		Dose:       medication.Dose,
+		Frequency:  medication.Frequency,
+		Route:      medication.Route,
+		StartDate:  formatOptionalDate(medication.StartDate),
+		StopDate:   formatOptionalDate(medication.StopDate),
+		Prescriber: medication.Prescriber,
+		Active:     medication.Active,
+	}
+}
+
+// medicationFromGRPC returns a Medication from a GRPC version. Routes are case-insensitive.
+// If a date is not valid, codes.InvalidArgument is returned.
+func medicationFromGRPC(medication *ppb.Patient_Medication) (*Medication, error) {
+	startDate, err := parseOptionalDate(medication.GetStartDate())
+	if err != nil {
+		return nil, fieldViolationError("medication.start_date", fmt.Sprintf("failed to parse start date: %v", err))
+	}
+	stopDate, err := parseOptionalDate(medication.GetStopDate())
+	if err != nil {
+		return nil, fieldViolationError("medication.stop_date", fmt.Sprintf("failed to parse stop date: %v", err))
+	}
+	return &Medication{
+		ID:         medication.GetId(),
+		Name:       strings.TrimSpace(medication.GetName()),
+		Dose:       strings.TrimSpace(medication.GetDose()),
+		Frequency:  strings.TrimSpace(medication.GetFrequency()),
+		Route:      strings.ToLower(strings.TrimSpace(medication.GetRoute())),
+		StartDate:  startDate,
+		StopDate:   stopDate,
+		Prescriber: strings.TrimSpace(medication.GetPrescriber()),
+		Active:     medication.GetActive(),
+	}, nil
+}
+
+// toGRPC returns a GRPC version of Insurance.
+func (insurance Insurance) toGRPC() *ppb.Patient_Insurance {
+	return &ppb.Patient_Insurance{
+		Id:           insurance.ID,
+		Provider:     insurance.Provider,
+		MemberNumber: insurance.MemberNumber,
+		Plan:         insurance.Plan,
+		ValidFrom:    formatOptionalDate(insurance.ValidFrom),
+		ValidTo:      formatOptionalDate(insurance.ValidTo),
+	}
+}
+
+// formatOptionalDate returns a date in birthDateFormat, or an empty string if the date is not set.
+func formatOptionalDate(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+	return date.Format(birthDateFormat)
+}
+
+// parseOptionalDate parses a date in birthDateFormat. Empty value is parsed as a date that is not set.
+func parseOptionalDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(birthDateFormat, value)
+}
+
 // toGRPC returns a GRPC version of EmergencyContact.
 func (contact EmergencyContact) toGRPC() *ppb.Patient_EmergencyContact {
 	return &ppb.Patient_EmergencyContact{
+		Id:        contact.ID,
 		Name:      contact.Name,
 		Closeness: contact.Closeness,
 		Phone:     contact.Phone,
@@ -73,16 +345,47 @@ func (contact EmergencyContact) toGRPC() *ppb.Patient_EmergencyContact {
 // emergencyContactFromGRPC returns an EmergencyContact from a GRPC version.
 func emergencyContactFromGRPC(contact *ppb.Patient_EmergencyContact) *EmergencyContact {
 	return &EmergencyContact{
+		ID:        contact.GetId(),
 		Name:      contact.GetName(),
 		Closeness: contact.GetCloseness(),
 		Phone:     contact.GetPhone(),
 	}
 }
 
+// toGRPC returns a GRPC version of Guardian.
+func (guardian Guardian) toGRPC() *ppb.Patient_Guardian {
+	return &ppb.Patient_Guardian{
+		Id:              guardian.ID,
+		Name:            guardian.Name,
+		Relationship:    guardian.Relationship,
+		Phone:           guardian.Phone,
+		LinkedPatientId: guardian.LinkedPatientID,
+		LinkedName:      guardian.LinkedName,
+		LinkedPhone:     guardian.LinkedPhone,
+	}
+}
+
+// guardianFromGRPC returns a Guardian from a GRPC version. The resolved linked name and phone are read-only.
+func guardianFromGRPC(guardian *ppb.Patient_Guardian) *Guardian {
+	return &Guardian{
+		ID:              guardian.GetId(),
+		Name:            strings.TrimSpace(guardian.GetName()),
+		Relationship:    strings.TrimSpace(guardian.GetRelationship()),
+		Phone:           guardian.GetPhone(),
+		LinkedPatientID: guardian.GetLinkedPatientId(),
+	}
+}
+
 // toGRPC returns a GRPC version of Patient.
 func (patient Patient) toGRPC() *ppb.Patient {
 	emergencyContacts := sf.Map(patient.EmergencyContacts,
 		func(contact *EmergencyContact) *ppb.Patient_EmergencyContact { return contact.toGRPC() })
+	allergies := sf.Map(patient.Allergies, func(allergy *Allergy) *ppb.Patient_Allergy { return allergy.toGRPC() })
+	conditions := sf.Map(patient.Conditions,
+		func(condition *Condition) *ppb.Patient_Condition { return condition.toGRPC() })
+	insurances := sf.Map(patient.Insurances,
+		func(insurance *Insurance) *ppb.Patient_Insurance { return insurance.toGRPC() })
+	guardians := sf.Map(patient.Guardians, func(guardian *Guardian) *ppb.Patient_Guardian { return guardian.toGRPC() })
 	return &ppb.Patient{
 		Id:                patient.ID,
 		Active:            patient.Active,
@@ -90,13 +393,65 @@ func (patient Patient) toGRPC() *ppb.Patient {
 		PersonalId:        patient.PersonalID.toGRPC(),
 		Gender:            patient.Gender,
 		PhoneNumber:       patient.PhoneNumber,
+		Address:           patient.Address.toGRPC(),
 		Languages:         patient.Languages,
 		BirthDate:         patient.BirthDate.Format(birthDateFormat),
 		Age:               int32(time.Now().Year() - patient.BirthDate.Year()),
 		ReferredBy:        patient.ReferredBy,
 		EmergencyContacts: emergencyContacts,
 		SpecialNote:       patient.SpecialNote,
-		NeedsTranslator:   patient.needs_translator,
+		NeedsTranslator:   patient.NeedsTranslator,
+		Version:           patient.Version,
+		Allergies:         allergies,
+		Conditions:        conditions,
+		Insurances:        insurances,
+		Guardians:         guardians,
+	}
+}
+
+// toGRPC returns a GRPC version of PatientSummary.
+func (summary PatientSummary) toGRPC() *ppb.PatientSummary {
+	return &ppb.PatientSummary{
+		Id:          summary.ID,
+		Name:        summary.Name,
+		PersonalId:  summary.PersonalID.toGRPC(),
+		BirthDate:   summary.BirthDate.Format(birthDateFormat),
+		PhoneNumber: summary.PhoneNumber,
+		Highlight:   summary.Highlight,
+	}
+}
+
+// toGRPC returns a GRPC version of FieldChange.
+func (change FieldChange) toGRPC() *ppb.PatientRevision_FieldChange {
+	return &ppb.PatientRevision_FieldChange{
+		Field:    change.Field,
+		OldValue: change.OldValue,
+		NewValue: change.NewValue,
+	}
+}
+
+// toGRPC returns a GRPC version of PatientRevision.
+func (revision PatientRevision) toGRPC() *ppb.PatientRevision {
+	return &ppb.PatientRevision{
+		Id:        revision.ID,
+		PatientId: revision.PatientID,
+		Action:    revision.Action,
+		Actor:     revision.Actor,
+		CreatedAt: timestamppb.New(revision.CreatedAt),
+		Changes:   sf.Map(revision.Changes, FieldChange.toGRPC),
+	}
+}
+
+// toGRPC returns a GRPC version of AccessLogEntry.
+func (entry AccessLogEntry) toGRPC() *ppb.PatientAccessLogEntry {
+	return &ppb.PatientAccessLogEntry{
+		Id:            entry.ID,
+		PatientId:     entry.PatientID,
+		Subject:       entry.Subject,
+		Roles:         entry.Roles,
+		Method:        entry.Method,
+		ClientAddress: entry.ClientAddress,
+		AccessedAt:    timestamppb.New(entry.AccessedAt),
 	}
 }
 
@@ -114,12 +469,15 @@ func patientFromGRPC(patient *ppb.Patient) (Patient, error) {
 		PersonalID:        personalIDFromGRPC(patient.GetPersonalId()),
 		Gender:            patient.GetGender(),
 		PhoneNumber:       patient.GetPhoneNumber(),
+		Address:           addressFromGRPC(patient.GetAddress()),
 		Languages:         patient.GetLanguages(),
 		BirthDate:         birthDate,
 		ReferredBy:        patient.GetReferredBy(),
 		EmergencyContacts: emergencyContacts,
+		Guardians:         sf.Map(patient.GetGuardians(), guardianFromGRPC),
 		SpecialNote:       patient.GetSpecialNote(),
 		NeedsTranslator:   patient.GetNeedsTranslator(),
+		Version:           patient.GetVersion(),
 	}, nil
 }
 
@@ -128,6 +486,15 @@ func createSchemaIfNotExists(ctx context.Context, db *bun.DB) error {
 	models := []interface{}{
 		(*Patient)(nil),
 		(*EmergencyContact)(nil),
+		(*Guardian)(nil),
+		(*Allergy)(nil),
+		(*Condition)(nil),
+		(*Medication)(nil),
+		(*Insurance)(nil),
+		(*PurgeRecord)(nil),
+		(*PatientRevision)(nil),
+		(*AccessLogEntry)(nil),
+		(*IdempotencyKey)(nil),
 	}
 
 	for _, model := range models {
@@ -146,6 +513,46 @@ func createSchemaIfNotExists(ctx context.Context, db *bun.DB) error {
 		return err
 	}
 
+	// Migration code. Add version column to the patient table for optimistic concurrency control.
+	if _, err := db.NewRaw(
+		"ALTER TABLE patients " +
+			"ADD COLUMN IF NOT EXISTS version integer NOT NULL DEFAULT 1").Exec(ctx); err != nil {
+		return err
+	}
+
+	// Migration code. Add merged_into column to the patient table for merged patients.
+	if _, err := db.NewRaw(
+		"ALTER TABLE patients " +
+			"ADD COLUMN IF NOT EXISTS merged_into integer").Exec(ctx); err != nil {
+		return err
+	}
+
+	// Migration code. Add address columns to the patient table.
+	if _, err := db.NewRaw(
+		"ALTER TABLE patients " +
+			"ADD COLUMN IF NOT EXISTS address_street varchar, " +
+			"ADD COLUMN IF NOT EXISTS address_house_number varchar, " +
+			"ADD COLUMN IF NOT EXISTS address_apartment varchar, " +
+			"ADD COLUMN IF NOT EXISTS address_city varchar, " +
+			"ADD COLUMN IF NOT EXISTS address_postal_code varchar, " +
+			"ADD COLUMN IF NOT EXISTS address_country varchar, " +
+			"ADD COLUMN IF NOT EXISTS address_directions varchar").Exec(ctx); err != nil {
+		return err
+	}
+
+	// Migration code. Generated columns can't be altered, so a text_searchable column without the address
+	// is dropped to be added again below.
+	if _, err := db.NewRaw(
+		"DO $$ BEGIN " +
+			"IF EXISTS (SELECT 1 FROM information_schema.columns " +
+			"WHERE table_name = 'patients' AND column_name = 'text_searchable' " +
+			"AND generation_expression NOT LIKE '%address_city%') THEN " +
+			"ALTER TABLE patients DROP COLUMN text_searchable; " +
+			"END IF; " +
+			"END $$").Exec(ctx); err != nil {
+		return err
+	}
+
 	// Postgres specific code. Add a text_searchable column for full-text search.
 	if _, err := db.NewRaw(
 		"ALTER TABLE patients " +
@@ -156,10 +563,95 @@ func createSchemaIfNotExists(ctx context.Context, db *bun.DB) error {
 			"setweight(to_tsvector('simple', coalesce(phone_number, '')), 'A')   || " +
 			"setweight(to_tsvector('simple', coalesce(name, '')), 'B')           || " +
 			"setweight(to_tsvector('simple', coalesce(special_note, '')), 'C')   || " +
+			"setweight(to_tsvector('simple', coalesce(address_street, '') || ' ' || " +
+			"coalesce(address_city, '') || ' ' || coalesce(address_postal_code, '')), 'C') || " +
 			"setweight(to_tsvector('simple', coalesce(referred_by, '')), 'D')" +
 			") STORED").Exec(ctx); err != nil {
 		return err
 	}
 
+	// Postgres specific code. Index searchable text, search matches it together with the trigrams of names
+	// and referrers and the search keys, and all of them have to be indexed to avoid scanning all the patients.
+	if _, err := db.NewRaw(
+		"CREATE INDEX IF NOT EXISTS patients_text_searchable_idx ON patients USING gin (text_searchable)").
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Postgres specific code. Enable trigram similarity for fuzzy search of names.
+	if _, err := db.NewRaw("CREATE EXTENSION IF NOT EXISTS pg_trgm").Exec(ctx); err != nil {
+		return err
+	}
+	// Postgres specific code. Index names and referrers by trigrams, so fuzzy search doesn't scan all the patients.
+	for _, index := range []string{
+		"CREATE INDEX IF NOT EXISTS patients_name_trgm_idx ON patients USING gin (name gin_trgm_ops)",
+		"CREATE INDEX IF NOT EXISTS patients_referred_by_trgm_idx ON patients USING gin (referred_by gin_trgm_ops)",
+	} {
+		if _, err := db.NewRaw(index).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Postgres specific code. Add a search_key column for search of names across scripts.
+	if err := createSearchKeyFunction(ctx, db); err != nil {
+		return err
+	}
+	if _, err := db.NewRaw(
+		"ALTER TABLE patients " +
+			"ADD COLUMN IF NOT EXISTS search_key tsvector " +
+			"GENERATED ALWAYS AS (to_tsvector('simple', patient_search_key(coalesce(name, '')))) STORED").
+		Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := db.NewRaw(
+		"CREATE INDEX IF NOT EXISTS patients_search_key_idx ON patients USING gin (search_key)").Exec(ctx); err != nil {
+		return err
+	}
+
+	// Postgres specific code. Index allergies, conditions, medications, insurances and guardians by their patients,
+	// they are fetched and filtered so. Guardians are also looked up by the patients they link to.
+	for _, index := range []string{
+		"CREATE INDEX IF NOT EXISTS allergies_patient_id_idx ON allergies (patient_id)",
+		"CREATE INDEX IF NOT EXISTS conditions_patient_id_idx ON conditions (patient_id)",
+		"CREATE INDEX IF NOT EXISTS medications_patient_id_idx ON medications (patient_id)",
+		"CREATE INDEX IF NOT EXISTS insurances_patient_id_idx ON insurances (patient_id)",
+		"CREATE INDEX IF NOT EXISTS guardians_patient_id_idx ON guardians (patient_id)",
+		"CREATE INDEX IF NOT EXISTS guardians_linked_patient_id_idx ON guardians (linked_patient_id)",
+	} {
+		if _, err := db.NewRaw(index).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Postgres specific code. Index revisions by their patients, histories are fetched, exported and purged so.
+	if _, err := db.NewRaw(
+		"CREATE INDEX IF NOT EXISTS patient_revisions_patient_id_idx ON patient_revisions (patient_id, created_at)").
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Postgres specific code. Index the access log by patients and by subjects, it is filtered so
+	// and ordered by access time.
+	for _, index := range []string{
+		"CREATE INDEX IF NOT EXISTS access_log_entries_patient_id_idx ON access_log_entries (patient_id, accessed_at)",
+		"CREATE INDEX IF NOT EXISTS access_log_entries_subject_idx ON access_log_entries (subject, accessed_at)",
+	} {
+		if _, err := db.NewRaw(index).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Migration code. Normalize personal IDs stored before they were normalized, before they are indexed.
+	if err := normalizePersonalIDs(ctx, db); err != nil {
+		return err
+	}
+
+	// Postgres specific code. Forbid patients that are not deleted from sharing a personal ID.
+	// Existing duplicates can't be resolved automatically, so until they are merged or deleted the index is skipped
+	// and checkPersonalIDAvailable alone guards writes. The index is created on the first start without duplicates.
+	if err := createPersonalIDIndex(ctx, db); err != nil {
+		return err
+	}
+
 	return nil
 }
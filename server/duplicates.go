@@ -0,0 +1,289 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	ppb "github.com/TekClinic/Patients-MicroService/patients_protobuf"
+	sf "github.com/sa-/slicefunk"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	patientResourceType = "patients.Patient"
+
+	// Weights of matching fields in the score of a potential duplicate, they sum up to 1.
+	duplicateNameWeight       = 0.5
+	duplicateBirthDateWeight  = 0.2
+	duplicatePhoneWeight      = 0.15
+	duplicatePersonalIDWeight = 0.15
+
+	// duplicateNameThreshold is the minimal similarity of names for them to be reported as matching.
+	duplicateNameThreshold = 0.5
+	// duplicateScoreThreshold is the minimal score of a potential duplicate.
+	// A name with a similarity of at least 0.7, such as a different spelling of the name, is enough on its own,
+	// and so is a matching birth date together with a matching phone number or personal ID.
+	// A matching birth date, phone number or personal ID alone is not.
+	duplicateScoreThreshold = 0.35
+
+	// duplicateNameSimilarityExpr is an SQL table expression of the similarity between the name of a patient
+	// and a given name named name_match. Names that have the same search key, such as the same name written
+	// in another script, are considered identical, see createSearchKeyFunction.
+	duplicateNameSimilarityExpr = "LATERAL (SELECT greatest(similarity(name, ?), " +
+		"(patient_search_key(?) <> '' AND search_key = to_tsvector('simple', patient_search_key(?)))::int) " +
+		"AS name_similarity) name_match"
+	// duplicateScoreExpr is an SQL expression of the score of a potential duplicate, a weighted sum of
+	// the name similarity and of the matching birth date, phone number and personal ID, see duplicateScore.
+	// Unset values are passed as NULL and never match.
+	duplicateScoreExpr = "name_similarity * ? + " +
+		"coalesce((birth_date = ?)::int, 0) * ? + " +
+		"coalesce((phone_number = nullif(?, ''))::int, 0) * ? + " +
+		"coalesce((personal_id_id = nullif(?, ''))::int, 0) * ?"
+)
+
+// duplicateCriteria describes a patient whose potential duplicates are searched for.
+type duplicateCriteria struct {
+	// excludeID is the id of the patient itself, zero if the patient is not stored yet
+	excludeID   int32
+	name        string
+	personalID  string
+	birthDate   *time.Time
+	phoneNumber string
+}
+
+// duplicateCandidate is a patient that may be a duplicate of another patient.
+type duplicateCandidate struct {
+	PatientSummary
+	NameSimilarity float64
+}
+
+// FindPotentialDuplicates returns patients that may be duplicates of a given patient, most probable first.
+// The patient is either a stored patient with the given id, or a patient described by the given fields.
+// Candidates are scored by the similarity of their names, including names written in other scripts,
+// and by matching birth dates, phone numbers and personal IDs.
+// Access to every returned patient is recorded in the access log.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:read permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// Limit value is required to be a positive value.
+// If neither id nor any of the fields are given, or the birth date is not valid, codes.InvalidArgument is returned.
+// If a patient with the given id doesn't exist, codes.NotFound is returned.
+func (server patientsServer) FindPotentialDuplicates(ctx context.Context, req *ppb.FindPotentialDuplicatesRequest) (
+	*ppb.FindPotentialDuplicatesResponse, error) {
+	if err := validatePagination(req.GetLimit(), 0); err != nil {
+		return nil, err
+	}
+	criteria, err := server.duplicateCriteriaFromRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []duplicateCandidate
+	scoreArgs := []interface{}{
+		duplicateNameWeight,
+		criteria.birthDate, duplicateBirthDateWeight,
+		criteria.phoneNumber, duplicatePhoneWeight,
+		criteria.personalID, duplicatePersonalIDWeight,
+	}
+	// Postgres specific code. Use trigram similarity and search keys to compare names.
+	err = server.db.NewSelect().
+		Model((*Patient)(nil)).
+		TableExpr(duplicateNameSimilarityExpr, criteria.name, criteria.name, criteria.name).
+		Column("id", "name", "personal_id_id", "personal_id_type", "birth_date", "phone_number").
+		ColumnExpr("name_similarity").
+		Where("id != ?", criteria.excludeID).
+		Where(duplicateScoreExpr+" >= ?", append(scoreArgs, duplicateScoreThreshold)...).
+		OrderExpr(duplicateScoreExpr+" DESC, id", scoreArgs...).
+		Limit(int(req.GetLimit())).
+		Scan(ctx, &candidates)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to fetch potential duplicates: %w", err).Error())
+	}
+
+	ids := sf.Map(candidates, func(candidate duplicateCandidate) int32 { return candidate.ID })
+	if err = server.logAccess(ctx, ppb.PatientsService_FindPotentialDuplicates_FullMethodName, ids...); err != nil {
+		return nil, err
+	}
+	return &ppb.FindPotentialDuplicatesResponse{
+		Results: sf.Map(candidates, func(candidate duplicateCandidate) *ppb.DuplicateCandidate {
+			return candidate.toGRPC(criteria)
+		}),
+	}, nil
+}
+
+// duplicateCriteriaFromRequest returns criteria of a FindPotentialDuplicates request.
+// If the request has an id, the criteria are taken from the stored patient with this id.
+func (server patientsServer) duplicateCriteriaFromRequest(ctx context.Context,
+	req *ppb.FindPotentialDuplicatesRequest) (duplicateCriteria, error) {
+	if req.GetId() != 0 {
+		patient := new(Patient)
+		err := server.db.NewSelect().Model(patient).Where("id = ?", req.GetId()).Scan(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			return duplicateCriteria{}, status.Error(codes.NotFound, "patient is not found")
+		}
+		if err != nil {
+			return duplicateCriteria{}, status.Error(codes.Internal,
+				fmt.Errorf("failed to fetch a patient: %w", err).Error())
+		}
+		return duplicateCriteria{
+			excludeID:   patient.ID,
+			name:        patient.Name,
+			personalID:  patient.PersonalID.ID,
+			birthDate:   &patient.BirthDate,
+			phoneNumber: patient.PhoneNumber,
+		}, nil
+	}
+
+	criteria := duplicateCriteria{
+		name:        req.GetName(),
+		personalID:  personalIDFromGRPC(req.GetPersonalId()).ID,
+		phoneNumber: req.GetPhoneNumber(),
+	}
+	if req.GetBirthDate() != "" {
+		birthDate, err := time.Parse(birthDateFormat, req.GetBirthDate())
+		if err != nil {
+			return duplicateCriteria{}, status.Error(codes.InvalidArgument,
+				fmt.Errorf("failed to parse birth date: %w", err).Error())
+		}
+		criteria.birthDate = &birthDate
+	}
+	if criteria.name == "" && criteria.personalID == "" && criteria.birthDate == nil && criteria.phoneNumber == "" {
+		return duplicateCriteria{}, status.Error(codes.InvalidArgument,
+			"either id or at least one of name, personal_id, birth_date and phone_number is required")
+	}
+	return criteria, nil
+}
+
+// toGRPC returns a GRPC version of duplicateCandidate, listing the fields that match the criteria.
+func (candidate duplicateCandidate) toGRPC(criteria duplicateCriteria) *ppb.DuplicateCandidate {
+	var matched []string
+	if candidate.NameSimilarity >= duplicateNameThreshold {
+		matched = append(matched, "name")
+	}
+	if criteria.personalID != "" && candidate.PersonalID.ID == criteria.personalID {
+		matched = append(matched, "personal_id")
+	}
+	if criteria.birthDate != nil && candidate.BirthDate.Equal(*criteria.birthDate) {
+		matched = append(matched, "birth_date")
+	}
+	if criteria.phoneNumber != "" && candidate.PhoneNumber == criteria.phoneNumber {
+		matched = append(matched, "phone_number")
+	}
+	return &ppb.DuplicateCandidate{
+		Patient:       candidate.PatientSummary.toGRPC(),
+		Score:         float32(duplicateScore(candidate.NameSimilarity, matched)),
+		MatchedFields: matched,
+	}
+}
+
+// duplicateScore returns the score of a potential duplicate with the given name similarity and matched fields,
+// as duplicateScoreExpr computes it in the database.
+func duplicateScore(nameSimilarity float64, matched []string) float64 {
+	weights := map[string]float64{
+		"birth_date":   duplicateBirthDateWeight,
+		"phone_number": duplicatePhoneWeight,
+		"personal_id":  duplicatePersonalIDWeight,
+	}
+	score := nameSimilarity * duplicateNameWeight
+	for _, field := range matched {
+		score += weights[field]
+	}
+	return score
+}
+
+// checkPersonalIDAvailable checks that no other patient that is not deleted has the personal ID of patient.
+// If such a patient exists, codes.AlreadyExists is returned with the id of the existing patient.
+func checkPersonalIDAvailable(ctx context.Context, db bun.IDB, patient *Patient) error {
+	var existingID int32
+	err := db.NewSelect().
+		Model((*Patient)(nil)).
+		Column("id").
+		Where("personal_id_type = ?", patient.PersonalID.Type).
+		Where("personal_id_id = ?", patient.PersonalID.ID).
+		Where("id != ?", patient.ID).
+		Limit(1).
+		Scan(ctx, &existingID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Errorf("failed to check personal ID: %w", err).Error())
+	}
+	return patientExistsError(existingID)
+}
+
+// createPersonalIDIndex creates the unique index of personal IDs of patients that are not deleted.
+// If some patients already share a personal ID, the index is not created and the ids of such patients are logged,
+// so they can be merged or deleted while the service keeps running.
+func createPersonalIDIndex(ctx context.Context, db bun.IDB) error {
+	_, err := db.NewRaw(
+		"CREATE UNIQUE INDEX IF NOT EXISTS " + personalIDIndex + " " +
+			"ON patients (personal_id_type, personal_id_id) WHERE deleted_at IS NULL").Exec(ctx)
+	var pgErr pgdriver.Error
+	if !errors.As(err, &pgErr) || pgErr.Field('C') != pgUniqueViolation {
+		return err
+	}
+
+	var duplicates []struct {
+		IDs []int32 `bun:"ids,array"`
+	}
+	err = db.NewSelect().
+		Model((*Patient)(nil)).
+		ColumnExpr("array_agg(id ORDER BY id) AS ids").
+		Group("personal_id_type", "personal_id_id").
+		Having("count(*) > 1").
+		Scan(ctx, &duplicates)
+	if err != nil {
+		return fmt.Errorf("failed to fetch patients that share a personal ID: %w", err)
+	}
+	for _, duplicate := range duplicates {
+		zap.L().Warn("Patients share a personal ID, merge or delete them to add the unique personal ID index",
+			zap.Int32s("ids", duplicate.IDs))
+	}
+	return nil
+}
+
+// isPersonalIDConflict returns whether err is a violation of the unique index of personal IDs.
+func isPersonalIDConflict(err error) bool {
+	var pgErr pgdriver.Error
+	return errors.As(err, &pgErr) && pgErr.Field('C') == pgUniqueViolation && pgErr.Field('n') == personalIDIndex
+}
+
+// personalIDConflictError returns the error of a failed transaction that stored a patient.
+// checkPersonalIDAvailable can't see a patient with the same personal ID stored by a concurrent transaction,
+// so such a patient is only caught by the unique index of personal IDs. If err is a violation of the index,
+// the existing patient is selected again once the transaction is over and codes.AlreadyExists is returned
+// with its id, like checkPersonalIDAvailable does. Other errors are returned as is.
+func personalIDConflictError(ctx context.Context, db bun.IDB, patient *Patient, err error) error {
+	if !isPersonalIDConflict(err) {
+		return err
+	}
+	if existsErr := checkPersonalIDAvailable(ctx, db, patient); existsErr != nil {
+		return existsErr
+	}
+	// the existing patient was deleted in the meantime
+	return status.Error(codes.Aborted, concurrentModificationMessage)
+}
+
+// patientExistsError returns a codes.AlreadyExists error that points at the existing patient with the given id,
+// both in the message and in a ResourceInfo detail.
+func patientExistsError(id int32) error {
+	message := fmt.Sprintf("patient with the same personal ID already exists: %d", id)
+	existing, err := status.New(codes.AlreadyExists, message).WithDetails(&errdetails.ResourceInfo{
+		ResourceType: patientResourceType,
+		ResourceName: strconv.Itoa(int(id)),
+		Description:  "patient with the same personal ID",
+	})
+	if err != nil {
+		return status.Error(codes.AlreadyExists, message)
+	}
+	return existing.Err()
+}
@@ -0,0 +1,46 @@
+package main
+
+import "testing"
+
+func TestDuplicateScore(t *testing.T) {
+	tests := []struct {
+		name           string
+		nameSimilarity float64
+		matched        []string
+		duplicate      bool
+	}{
+		{name: "identical name", nameSimilarity: 1, matched: []string{"name"}, duplicate: true},
+		{name: "similar name", nameSimilarity: 0.7, matched: []string{"name"}, duplicate: true},
+		{name: "loosely similar name", nameSimilarity: 0.6, matched: []string{"name"}, duplicate: false},
+		{name: "birth date", matched: []string{"birth_date"}, duplicate: false},
+		{name: "phone number", matched: []string{"phone_number"}, duplicate: false},
+		{name: "personal ID", matched: []string{"personal_id"}, duplicate: false},
+		{name: "birth date and phone number", matched: []string{"birth_date", "phone_number"}, duplicate: true},
+		{name: "birth date and personal ID", matched: []string{"birth_date", "personal_id"}, duplicate: true},
+		{name: "phone number and personal ID", matched: []string{"phone_number", "personal_id"}, duplicate: false},
+		{
+			name:           "loosely similar name and birth date",
+			nameSimilarity: 0.3,
+			matched:        []string{"birth_date"},
+			duplicate:      true,
+		},
+		{
+			name:           "everything",
+			nameSimilarity: 1,
+			matched:        []string{"name", "birth_date", "phone_number", "personal_id"},
+			duplicate:      true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			score := duplicateScore(test.nameSimilarity, test.matched)
+			if duplicate := score >= duplicateScoreThreshold; duplicate != test.duplicate {
+				t.Errorf("duplicateScore(%v, %v) = %v, duplicate = %v, want %v",
+					test.nameSimilarity, test.matched, score, duplicate, test.duplicate)
+			}
+			if score > 1 {
+				t.Errorf("duplicateScore(%v, %v) = %v, want at most 1", test.nameSimilarity, test.matched, score)
+			}
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	ppb "github.com/TekClinic/Patients-MicroService/patients_protobuf"
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// searchQueryExpr is an SQL table expression of a prefix full-text query named query, built from a search term.
+	searchQueryExpr = "replace(websearch_to_tsquery('simple', ?)::text || ' ',''' ',''':*') query"
+	// searchTermExpr is an SQL table expression of the search term itself named term, used for fuzzy matching.
+	searchTermExpr = "btrim(?) term"
+	// searchKeyQueryExpr is an SQL table expression of a full-text query named key_query,
+	// built from the search key of a search term, see createSearchKeyFunction.
+	// Search keys have no vowels, so short keys such as dn of Dan are prefixes of too many names.
+	// Only words of the key with at least 3 letters are matched as prefixes, shorter words have to match exactly.
+	searchKeyQueryExpr = "regexp_replace(websearch_to_tsquery('simple', patient_search_key(?))::text, " +
+		`'''([a-z]{3,})''', '''\1'':*', 'g') key_query`
+
+	// searchSimilarityExpr is an SQL expression of the trigram similarity between the search term
+	// and the best matching part of the name or the referrer of a patient.
+	searchSimilarityExpr = "greatest(word_similarity(term, name), word_similarity(term, referred_by))"
+	// searchRankExpr is an SQL expression of the search relevance of a patient.
+	// It blends the full-text rank with the rank of the name written in another script
+	// and the trigram similarity, so transliterated and misspelled names are ranked as well.
+	searchRankExpr = "ts_rank(text_searchable, query::tsquery) + ts_rank(search_key, key_query::tsquery) + " +
+		searchSimilarityExpr
+
+	// validInsuranceExpr is an SQL expression checking that a patient has an insurance valid today.
+	validInsuranceExpr = "EXISTS (SELECT 1 FROM insurances WHERE insurances.patient_id = patient.id " +
+		"AND (insurances.valid_from IS NULL OR insurances.valid_from <= CURRENT_DATE) " +
+		"AND (insurances.valid_to IS NULL OR insurances.valid_to >= CURRENT_DATE))"
+
+	// searchSimilarityThreshold is the minimal trigram similarity for a patient to match a search term fuzzily.
+	// It is low enough to tolerate a single typo or a different spelling of a short name.
+	// It is set as the word similarity threshold of every database connection, see searchSimilarityParam.
+	searchSimilarityThreshold = 0.4
+	// searchSimilarityParam is the Postgres parameter of the threshold of the <% word similarity operator.
+	searchSimilarityParam = "pg_trgm.word_similarity_threshold"
+)
+
+// withSearchTables adds the full-text query, the search term and the search key query of a search to query
+// as tables named query, term and key_query respectively.
+func withSearchTables(query *bun.SelectQuery, search string) *bun.SelectQuery {
+	return query.
+		TableExpr(searchQueryExpr, search).
+		TableExpr(searchTermExpr, search).
+		TableExpr(searchKeyQueryExpr, search)
+}
+
+// applyPatientSearch restricts query to patients matching a free-text search.
+// A patient matches if the search matches prefixes of its searchable fields, if the search key of the search
+// matches prefixes of the search key of its name, so names written in another script or with diacritics match,
+// or if the search is similar enough to its name or referrer, so typos and different spellings are tolerated.
+// Similarity is checked by the <% operator rather than by comparing word_similarity with the threshold,
+// so trigram indexes of names and referrers can be used.
+// Empty search leaves the query unchanged.
+func applyPatientSearch(query *bun.SelectQuery, search string) *bun.SelectQuery {
+	if search == "" {
+		return query
+	}
+	// Postgres specific code. Use full-text search and trigram similarity to search for patients.
+	return withSearchTables(query, search).
+		WhereGroup(" AND ", func(query *bun.SelectQuery) *bun.SelectQuery {
+			return query.
+				Where("text_searchable @@ query::tsquery").
+				WhereOr("search_key @@ key_query::tsquery").
+				WhereOr("term <% name").
+				WhereOr("term <% referred_by")
+		})
+}
+
+// patientOrder defines an order of patients by a sort key, using patient id as a tiebreaker
+// to keep the order deterministic across pages.
+type patientOrder struct {
+	// key is an SQL expression of the sort key, empty if patients are ordered by id only
+	key string
+	// keyType is an SQL type to which sort key values are cast when comparing them
+	keyType       string
+	keyDescending bool
+	tieDescending bool
+}
+
+// newPatientOrder returns an order of patients by the requested field.
+// By default, patients are ordered by search relevance if there is a search term, otherwise by id.
+// If the requested field is not known, codes.InvalidArgument is returned.
+func newPatientOrder(order *ppb.PatientOrder, search string) (patientOrder, error) {
+	descending := order.GetDescending()
+	switch order.GetField() {
+	case ppb.PatientOrder_DEFAULT:
+		if search != "" {
+			return patientOrder{
+				key:           searchRankExpr,
+				keyType:       "real",
+				keyDescending: true,
+			}, nil
+		}
+		return patientOrder{tieDescending: descending}, nil
+	case ppb.PatientOrder_NAME:
+		return patientOrder{key: "name", keyType: "text", keyDescending: descending, tieDescending: descending}, nil
+	case ppb.PatientOrder_BIRTH_DATE:
+		return patientOrder{key: "birth_date", keyType: "timestamptz", keyDescending: descending,
+			tieDescending: descending}, nil
+	case ppb.PatientOrder_CREATED_AT:
+		return patientOrder{key: "created_at", keyType: "timestamptz", keyDescending: descending,
+			tieDescending: descending}, nil
+	case ppb.PatientOrder_ID:
+		return patientOrder{tieDescending: descending}, nil
+	default:
+		return patientOrder{}, status.Error(codes.InvalidArgument, "unknown order field")
+	}
+}
+
+// apply orders query according to the order.
+func (order patientOrder) apply(query *bun.SelectQuery) *bun.SelectQuery {
+	if order.key != "" {
+		query = query.OrderExpr("? "+sqlDirection(order.keyDescending), bun.Safe(order.key))
+	}
+	return query.OrderExpr("id " + sqlDirection(order.tieDescending))
+}
+
+// sqlDirection returns an SQL keyword of the order direction.
+func sqlDirection(descending bool) string {
+	if descending {
+		return "DESC"
+	}
+	return "ASC"
+}
+
+// applyPatientFilter restricts query to patients matching all the set fields of filter.
+// Nil filter leaves the query unchanged.
+// If some filter value is not valid, codes.InvalidArgument is returned.
+func applyPatientFilter(query *bun.SelectQuery, filter *ppb.PatientFilter) (*bun.SelectQuery, error) {
+	if filter == nil {
+		return query, nil
+	}
+
+	if filter.Gender != nil {
+		query = query.Where("gender = ?", int32(filter.GetGender()))
+	}
+	if filter.Active != nil {
+		query = query.Where("active = ?", filter.GetActive())
+	}
+	if filter.NeedsTranslator != nil {
+		query = query.Where("needs_translator = ?", filter.GetNeedsTranslator())
+	}
+	if filter.GetLanguage() != "" {
+		query = query.Where("? = ANY(languages)", filter.GetLanguage())
+	}
+	if filter.GetReferredBy() != "" {
+		query = query.Where("lower(referred_by) = lower(?)", filter.GetReferredBy())
+	}
+	if filter.GetCity() != "" {
+		query = query.Where("lower(address_city) = lower(?)", strings.TrimSpace(filter.GetCity()))
+	}
+	if filter.GetAllergy() != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM allergies WHERE allergies.patient_id = patient.id "+
+			"AND allergies.status = ? AND lower(allergies.code) = lower(?))",
+			int32(ppb.Patient_ACTIVE), strings.TrimSpace(filter.GetAllergy()))
+	}
+	if filter.Insured != nil {
+		if filter.GetInsured() {
+			query = query.Where(validInsuranceExpr)
+		} else {
+			query = query.Where("NOT " + validInsuranceExpr)
+		}
+	}
+
+	query, err := applyBirthDateFilter(query, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.GetCreatedFrom() != nil && filter.GetCreatedTo() != nil &&
+		filter.GetCreatedFrom().AsTime().After(filter.GetCreatedTo().AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "created_from has to be before created_to")
+	}
+	if filter.GetCreatedFrom() != nil {
+		query = query.Where("created_at >= ?", filter.GetCreatedFrom().AsTime())
+	}
+	if filter.GetCreatedTo() != nil {
+		query = query.Where("created_at < ?", filter.GetCreatedTo().AsTime())
+	}
+	return query, nil
+}
+
+// applyBirthDateFilter restricts query to patients matching birth date range and age range of filter.
+// Both ranges are inclusive.
+// If some range value is not valid, codes.InvalidArgument is returned.
+func applyBirthDateFilter(query *bun.SelectQuery, filter *ppb.PatientFilter) (*bun.SelectQuery, error) {
+	if filter.GetBirthDateFrom() != "" {
+		from, err := time.Parse(birthDateFormat, filter.GetBirthDateFrom())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument,
+				fmt.Errorf("failed to parse birth_date_from: %w", err).Error())
+		}
+		query = query.Where("birth_date >= ?", from)
+	}
+	if filter.GetBirthDateTo() != "" {
+		to, err := time.Parse(birthDateFormat, filter.GetBirthDateTo())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument,
+				fmt.Errorf("failed to parse birth_date_to: %w", err).Error())
+		}
+		query = query.Where("birth_date <= ?", to)
+	}
+
+	if (filter.MinAge != nil && filter.GetMinAge() < 0) || (filter.MaxAge != nil && filter.GetMaxAge() < 0) {
+		return nil, status.Error(codes.InvalidArgument, "age has to be a non-negative integer")
+	}
+	if filter.MinAge != nil && filter.MaxAge != nil && filter.GetMinAge() > filter.GetMaxAge() {
+		return nil, status.Error(codes.InvalidArgument, "min_age has to be less or equal to max_age")
+	}
+	today := time.Now().UTC().Truncate(hoursInDay * time.Hour)
+	if filter.MinAge != nil {
+		// patients of age at least N were born N years ago or earlier
+		query = query.Where("birth_date <= ?", today.AddDate(-int(filter.GetMinAge()), 0, 0))
+	}
+	if filter.MaxAge != nil {
+		// patients of age at most N were born less than N+1 years ago
+		query = query.Where("birth_date > ?", today.AddDate(-int(filter.GetMaxAge())-1, 0, 0))
+	}
+	return query, nil
+}
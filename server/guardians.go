@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sf "github.com/sa-/slicefunk"
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	guardiansField = "guardians"
+
+	// adultAge is the age from which patients don't need a guardian.
+	adultAge = 18
+
+	// Rules of guardians reported as field violations.
+	guardiansRequiredRule = "guardians_required"
+	guardianPatientRule   = "guardian_patient"
+	guardianSelfRule      = "guardian_self"
+)
+
+// isMinor returns whether a person born at birthDate is younger than adultAge at the given time.
+func isMinor(birthDate time.Time, at time.Time) bool {
+	return birthDate.AddDate(adultAge, 0, 0).After(at)
+}
+
+// checkGuardiansRequired checks that a patient younger than adultAge has at least one guardian.
+// The field is the path of the patient in the request, like in validationError.
+// If the patient is a minor without guardians, codes.InvalidArgument is returned.
+func (server patientsServer) checkGuardiansRequired(ctx context.Context, field string, patient *Patient) error {
+	if len(patient.Guardians) == 0 && isMinor(patient.BirthDate, time.Now()) {
+		return server.ruleViolationError(ctx, fieldPath(field, guardiansField), guardiansField, guardiansRequiredRule)
+	}
+	return nil
+}
+
+// checkLinkedGuardians checks that every guardian of a patient linked to another patient
+// links to an existing patient other than the patient itself.
+// Links that the previous guardians of the patient already had aren't checked again, so they stay valid
+// after the linked patient is deleted, just as resolveLinkedGuardians still resolves them.
+// The field is the path of the patient in the request, like in validationError.
+// If some link is not valid, codes.InvalidArgument is returned.
+func (server patientsServer) checkLinkedGuardians(ctx context.Context, tx bun.Tx, field string,
+	previous []*Guardian, patient *Patient) error {
+	kept := make(map[int32]struct{}, len(previous))
+	for _, guardian := range previous {
+		kept[guardian.LinkedPatientID] = struct{}{}
+	}
+	linked := sf.Filter(patient.Guardians, func(guardian *Guardian) bool {
+		_, isKept := kept[guardian.LinkedPatientID]
+		return guardian.LinkedPatientID != 0 && !isKept
+	})
+	if len(linked) == 0 {
+		return nil
+	}
+
+	var existingIDs []int32
+	err := tx.NewSelect().
+		Model((*Patient)(nil)).
+		Column("id").
+		Where("id IN (?)", bun.In(sf.Map(linked, func(guardian *Guardian) int32 { return guardian.LinkedPatientID }))).
+		Scan(ctx, &existingIDs)
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Errorf("failed to fetch guardian patients: %w", err).Error())
+	}
+	existing := make(map[int32]struct{}, len(existingIDs))
+	for _, id := range existingIDs {
+		existing[id] = struct{}{}
+	}
+
+	for i, guardian := range patient.Guardians {
+		path := fieldPath(field, fmt.Sprintf("%s[%d].%s", guardiansField, i, protoFieldName("LinkedPatientID")))
+		if _, isKept := kept[guardian.LinkedPatientID]; guardian.LinkedPatientID == 0 || isKept {
+			continue
+		}
+		if guardian.LinkedPatientID == patient.ID {
+			return server.ruleViolationError(ctx, path, protoFieldName("LinkedPatientID"), guardianSelfRule)
+		}
+		if _, found := existing[guardian.LinkedPatientID]; !found {
+			return server.ruleViolationError(ctx, path, protoFieldName("LinkedPatientID"), guardianPatientRule)
+		}
+	}
+	return nil
+}
+
+// checkNotOnlyGuardian checks that a patient with the given id is not the only guardian of a minor
+// that is not deleted, so that deleting, purging or merging the patient doesn't leave the minor without guardians.
+// If it is, codes.FailedPrecondition is returned with the id of the minor.
+func checkNotOnlyGuardian(ctx context.Context, tx bun.Tx, id int32) error {
+	var minorID int32
+	err := tx.NewSelect().
+		Model((*Patient)(nil)).
+		Column("id").
+		Where("? != ?", bun.Ident("patient.id"), id).
+		Where("birth_date > ?", time.Now().AddDate(-adultAge, 0, 0)).
+		Where("EXISTS (SELECT 1 FROM guardians WHERE patient_id = ? AND linked_patient_id = ?)",
+			bun.Ident("patient.id"), id).
+		Where("NOT EXISTS (SELECT 1 FROM guardians WHERE patient_id = ? AND linked_patient_id IS DISTINCT FROM ?)",
+			bun.Ident("patient.id"), id).
+		Limit(1).
+		Scan(ctx, &minorID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Errorf("failed to check guarded patients: %w", err).Error())
+	}
+	return status.Error(codes.FailedPrecondition,
+		fmt.Sprintf("patient is the only guardian of patient %d, add another guardian to it first", minorID))
+}
+
+// saveGuardians synchronizes stored guardians of a patient with the given ones, like saveEmergencyContacts.
+// The field is the path of the patient in the request, like in validationError.
+// If a guardian has an id that doesn't belong to the patient, codes.InvalidArgument is returned.
+func saveGuardians(ctx context.Context, tx bun.Tx, field string, patientID int32, previous []*Guardian,
+	guardians []*Guardian) error {
+	existing := make(map[int32]struct{}, len(previous))
+	for _, guardian := range previous {
+		existing[guardian.ID] = struct{}{}
+	}
+	kept := make([]int32, 0, len(guardians))
+	for i, guardian := range guardians {
+		if guardian.ID == 0 {
+			continue
+		}
+		if _, exists := existing[guardian.ID]; !exists {
+			return fieldViolationError(fieldPath(field, fmt.Sprintf("%s[%d].id", guardiansField, i)),
+				fmt.Sprintf("guardian %d doesn't belong to the patient", guardian.ID))
+		}
+		kept = append(kept, guardian.ID)
+	}
+
+	if err := deleteRemovedRecords(ctx, tx, (*Guardian)(nil), patientID, kept); err != nil {
+		return err
+	}
+	for _, guardian := range guardians {
+		guardian.PatientID = patientID
+		if err := savePatientRecord(ctx, tx, guardian, guardian.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// redirectGuardianLinks links guardians that link to a merged source patient to the target patient instead.
+// Guardians of the target that would link to the target itself are deleted.
+func redirectGuardianLinks(ctx context.Context, tx bun.Tx, sourceID int32, targetID int32) error {
+	_, err := tx.NewUpdate().
+		Model((*Guardian)(nil)).
+		Set("linked_patient_id = ?", targetID).
+		Where("linked_patient_id = ?", sourceID).
+		Exec(ctx)
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Errorf("failed to redirect guardians: %w", err).Error())
+	}
+	_, err = tx.NewDelete().
+		Model((*Guardian)(nil)).
+		Where("patient_id = ?", targetID).
+		Where("linked_patient_id = ?", targetID).
+		Exec(ctx)
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Errorf("failed to delete guardians: %w", err).Error())
+	}
+	return nil
+}
+
+// resolveLinkedGuardians fills the linked names and phones of guardians of patients that are linked to other
+// patients from the linked patients. Deleted linked patients are resolved as well.
+func (server patientsServer) resolveLinkedGuardians(ctx context.Context, patients ...*Patient) error {
+	var linked []*Guardian
+	for _, patient := range patients {
+		linked = append(linked, sf.Filter(patient.Guardians, func(guardian *Guardian) bool {
+			return guardian.LinkedPatientID != 0
+		})...)
+	}
+	if len(linked) == 0 {
+		return nil
+	}
+
+	var linkedPatients []Patient
+	err := server.db.NewSelect().
+		Model(&linkedPatients).
+		Column("id", "name", "phone_number").
+		Where("id IN (?)", bun.In(sf.Map(linked, func(guardian *Guardian) int32 { return guardian.LinkedPatientID }))).
+		WhereAllWithDeleted().
+		Scan(ctx)
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Errorf("failed to fetch guardian patients: %w", err).Error())
+	}
+	byID := make(map[int32]Patient, len(linkedPatients))
+	for _, linkedPatient := range linkedPatients {
+		byID[linkedPatient.ID] = linkedPatient
+	}
+
+	for _, guardian := range linked {
+		guardian.LinkedName = byID[guardian.LinkedPatientID].Name
+		guardian.LinkedPhone = byID[guardian.LinkedPatientID].PhoneNumber
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	ppb "github.com/TekClinic/Patients-MicroService/patients_protobuf"
+	sf "github.com/sa-/slicefunk"
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetPatientHistory returns a list of revisions of a patient with the given id, most recent first.
+// Revisions contain values of the patient, so access to the patient is recorded in the access log.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:read permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// Offset value is used for pagination. Required be a non-negative value.
+// Limit value is used for pagination. Required to be a positive value.
+// If a patient with a given id doesn't exist, codes.NotFound is returned.
+func (server patientsServer) GetPatientHistory(ctx context.Context, req *ppb.GetPatientHistoryRequest) (
+	*ppb.GetPatientHistoryResponse, error) {
+	if err := validatePagination(req.GetLimit(), req.GetOffset()); err != nil {
+		return nil, err
+	}
+
+	exists, err := server.db.NewSelect().
+		Model((*Patient)(nil)).
+		Where("id = ?", req.GetId()).
+		WhereAllWithDeleted().
+		Exists(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to fetch a patient: %w", err).Error())
+	}
+	if !exists {
+		return nil, status.Error(codes.NotFound, "patient is not found")
+	}
+
+	var revisions []PatientRevision
+	count, err := server.db.NewSelect().
+		Model(&revisions).
+		Where("patient_id = ?", req.GetId()).
+		OrderExpr("created_at DESC, id DESC").
+		Offset(int(req.GetOffset())).
+		Limit(int(req.GetLimit())).
+		ScanAndCount(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to fetch patient history: %w", err).Error())
+	}
+	if err = server.logAccess(ctx, ppb.PatientsService_GetPatientHistory_FullMethodName, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &ppb.GetPatientHistoryResponse{
+		Count:   int32(count),
+		Results: sf.Map(revisions, PatientRevision.toGRPC),
+	}, nil
+}
+
+// recordRevision stores a revision of a patient performed by the actor of ctx.
+// Changes are computed between before and after states of the patient, nil state is considered empty.
+func recordRevision(ctx context.Context, db bun.IDB, patientID int32, action ppb.PatientRevision_Action,
+	before *Patient, after *Patient) error {
+	revision := PatientRevision{
+		PatientID: patientID,
+		Action:    action,
+		Actor:     actorFromContext(ctx).subject,
+		Changes:   diffPatients(before, after),
+	}
+	if _, err := db.NewInsert().Model(&revision).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record a patient revision: %w", err)
+	}
+	return nil
+}
+
+// diffPatients returns changes between two states of a patient ordered by field path.
+func diffPatients(before *Patient, after *Patient) []FieldChange {
+	oldFields := before.fields()
+	newFields := after.fields()
+
+	paths := make(map[string]struct{}, len(oldFields)+len(newFields))
+	for path := range oldFields {
+		paths[path] = struct{}{}
+	}
+	for path := range newFields {
+		paths[path] = struct{}{}
+	}
+
+	changes := make([]FieldChange, 0)
+	for path := range paths {
+		if oldFields[path] != newFields[path] {
+			changes = append(changes, FieldChange{
+				Field:    path,
+				OldValue: oldFields[path],
+				NewValue: newFields[path],
+			})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	return changes
+}
+
+// fields flattens a patient into a map from proto field paths to string values of the fields.
+// Nil patient has no fields.
+func (patient *Patient) fields() map[string]string {
+	fields := make(map[string]string)
+	if patient == nil {
+		return fields
+	}
+
+	fields["active"] = strconv.FormatBool(patient.Active)
+	fields["name"] = patient.Name
+	fields["personal_id.id"] = patient.PersonalID.ID
+	fields["personal_id.type"] = patient.PersonalID.Type
+	fields["gender"] = patient.Gender.String()
+	fields["phone_number"] = patient.PhoneNumber
+	fields["address.street"] = patient.Address.Street
+	fields["address.house_number"] = patient.Address.HouseNumber
+	fields["address.apartment"] = patient.Address.Apartment
+	fields["address.city"] = patient.Address.City
+	fields["address.postal_code"] = patient.Address.PostalCode
+	fields["address.country"] = patient.Address.Country
+	fields["address.directions"] = patient.Address.Directions
+	fields["languages"] = strings.Join(patient.Languages, ", ")
+	fields["needs_translator"] = strconv.FormatBool(patient.NeedsTranslator)
+	fields["birth_date"] = patient.BirthDate.Format(birthDateFormat)
+	fields["referred_by"] = patient.ReferredBy
+	fields["special_note"] = patient.SpecialNote
+	if patient.MergedInto != 0 {
+		fields["merged_into"] = strconv.Itoa(int(patient.MergedInto))
+	}
+	// emergency contacts are keyed by their ids, so removal of a contact doesn't affect others
+	for _, contact := range patient.EmergencyContacts {
+		prefix := fmt.Sprintf("emergency_contacts[id=%d].", contact.ID)
+		fields[prefix+"name"] = contact.Name
+		fields[prefix+"closeness"] = contact.Closeness
+		fields[prefix+"phone"] = contact.Phone
+	}
+	patient.addRecordFields(fields)
+	return fields
+}
+
+// addRecordFields adds fields of guardians, allergies, conditions, medications and insurances of a patient
+// to fields, keyed by their ids like emergency contacts.
+func (patient *Patient) addRecordFields(fields map[string]string) {
+	for _, guardian := range patient.Guardians {
+		prefix := fmt.Sprintf("guardians[id=%d].", guardian.ID)
+		fields[prefix+"name"] = guardian.Name
+		fields[prefix+"relationship"] = guardian.Relationship
+		fields[prefix+"phone"] = guardian.Phone
+		fields[prefix+"linked_patient_id"] = strconv.Itoa(int(guardian.LinkedPatientID))
+	}
+	for _, allergy := range patient.Allergies {
+		prefix := fmt.Sprintf("allergies[id=%d].", allergy.ID)
+		fields[prefix+"code"] = allergy.Code
+		fields[prefix+"severity"] = allergy.Severity.String()
+		fields[prefix+"reaction"] = allergy.Reaction
+		fields[prefix+"onset_date"] = formatOptionalDate(allergy.OnsetDate)
+		fields[prefix+"status"] = allergy.Status.String()
+		fields[prefix+"resolved_date"] = formatOptionalDate(allergy.ResolvedDate)
+	}
+	for _, condition := range patient.Conditions {
+		prefix := fmt.Sprintf("conditions[id=%d].", condition.ID)
+		fields[prefix+"code"] = condition.Code
+		fields[prefix+"severity"] = condition.Severity.String()
+		fields[prefix+"onset_date"] = formatOptionalDate(condition.OnsetDate)
+		fields[prefix+"status"] = condition.Status.String()
+		fields[prefix+"resolved_date"] = formatOptionalDate(condition.ResolvedDate)
+	}
+	for _, medication := range patient.Medications {
+		prefix := fmt.Sprintf("medications[id=%d].", medication.ID)
+		fields[prefix+"name"] = medication.Name
+		fields[prefix+"dose"] = medication.Dose
+		fields[prefix+"frequency"] = medication.Frequency
+		fields[prefix+"route"] = medication.Route
+		fields[prefix+"start_date"] = formatOptionalDate(medication.StartDate)
+		fields[prefix+"stop_date"] = formatOptionalDate(medication.StopDate)
+		fields[prefix+"prescriber"] = medication.Prescriber
+		fields[prefix+"active"] = strconv.FormatBool(medication.Active)
+	}
+	for _, insurance := range patient.Insurances {
+		prefix := fmt.Sprintf("insurances[id=%d].", insurance.ID)
+		fields[prefix+"provider"] = insurance.Provider
+		fields[prefix+"member_number"] = insurance.MemberNumber
+		fields[prefix+"plan"] = insurance.Plan
+		fields[prefix+"valid_from"] = formatOptionalDate(insurance.ValidFrom)
+		fields[prefix+"valid_to"] = formatOptionalDate(insurance.ValidTo)
+	}
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	ms "github.com/TekClinic/MicroService-Lib"
+	ppb "github.com/TekClinic/Patients-MicroService/patients_protobuf"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	envIdempotencyKeyTTLHours     = "IDEMPOTENCY_KEY_TTL_HOURS"
+	defaultIdempotencyKeyTTLHours = "24"
+
+	// idempotencyKeyMetadata is a GRPC metadata key that may carry an idempotency key instead of the request.
+	idempotencyKeyMetadata  = "idempotency-key"
+	maxIdempotencyKeyLength = 255
+
+	idempotencyKeysJobInterval = time.Hour
+)
+
+// idempotencyKeyFromRequest returns the idempotency key of a CreatePatient request.
+// The key is taken from the request, or from the idempotency-key metadata if the request has no key.
+// Empty key means that the request is not idempotent.
+// If the key is too long, codes.InvalidArgument is returned.
+func idempotencyKeyFromRequest(ctx context.Context, req *ppb.CreatePatientRequest) (string, error) {
+	key := req.GetIdempotencyKey()
+	if key == "" {
+		if values := metadata.ValueFromIncomingContext(ctx, idempotencyKeyMetadata); len(values) > 0 {
+			key = values[0]
+		}
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return "", status.Error(codes.InvalidArgument,
+			fmt.Sprintf("idempotency key has to be at most %d characters long", maxIdempotencyKeyLength))
+	}
+	return key, nil
+}
+
+// createPatientRequestHash returns a hash of the payload of a CreatePatient request.
+// The token and the idempotency key are not a part of the payload, so retries with a refreshed token match.
+func createPatientRequestHash(req *ppb.CreatePatientRequest) string {
+	payload, _ := proto.Clone(req).(*ppb.CreatePatientRequest)
+	payload.Token = ""
+	payload.IdempotencyKey = ""
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(payload)
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+// claimIdempotencyKey claims an idempotency key of the actor of ctx for a request with the given hash.
+// If the key is already claimed by a committed request with the same hash, the id of the patient created
+// by that request is returned, otherwise zero is returned and the key has to be completed in the same tx.
+// Concurrent claims of the same key wait for each other, so only one of them creates a patient.
+// If the key was claimed by a request with a different hash, codes.InvalidArgument is returned.
+func (server patientsServer) claimIdempotencyKey(ctx context.Context, tx bun.Tx, key string, hash string) (
+	int32, error) {
+	subject := actorFromContext(ctx).subject
+	// an expired key is released, so it can be claimed again
+	_, err := tx.NewDelete().
+		Model((*IdempotencyKey)(nil)).
+		Where("key = ?", key).
+		Where("subject = ?", subject).
+		Where("created_at < ?", time.Now().Add(-server.idempotencyTTL)).
+		Exec(ctx)
+	if err != nil {
+		return 0, status.Error(codes.Internal, fmt.Errorf("failed to release an idempotency key: %w", err).Error())
+	}
+
+	claimed := &IdempotencyKey{Key: key, Subject: subject, RequestHash: hash}
+	res, err := tx.NewInsert().Model(claimed).On("CONFLICT DO NOTHING").Exec(ctx)
+	if err != nil {
+		return 0, status.Error(codes.Internal, fmt.Errorf("failed to claim an idempotency key: %w", err).Error())
+	}
+	if rows, rowsErr := res.RowsAffected(); rowsErr != nil || rows > 0 {
+		return 0, nil
+	}
+
+	existing := &IdempotencyKey{Key: key, Subject: subject}
+	if err = tx.NewSelect().Model(existing).WherePK().Scan(ctx); err != nil {
+		return 0, status.Error(codes.Internal, fmt.Errorf("failed to fetch an idempotency key: %w", err).Error())
+	}
+	if existing.RequestHash != hash {
+		return 0, status.Error(codes.InvalidArgument, "idempotency key was already used for a different request")
+	}
+	return existing.PatientID, nil
+}
+
+// completeIdempotencyKey stores the id of the patient created by the request that claimed an idempotency key
+// of the actor of ctx.
+func completeIdempotencyKey(ctx context.Context, tx bun.Tx, key string, patientID int32) error {
+	_, err := tx.NewUpdate().
+		Model((*IdempotencyKey)(nil)).
+		Set("patient_id = ?", patientID).
+		Where("key = ?", key).
+		Where("subject = ?", actorFromContext(ctx).subject).
+		Exec(ctx)
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Errorf("failed to complete an idempotency key: %w", err).Error())
+	}
+	return nil
+}
+
+// deleteExpiredIdempotencyKeys deletes idempotency keys older than the TTL. Returns the number of deleted keys.
+func (server patientsServer) deleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	res, err := server.db.NewDelete().
+		Model((*IdempotencyKey)(nil)).
+		Where("created_at < ?", time.Now().Add(-server.idempotencyTTL)).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+// runIdempotencyKeysJob deletes expired idempotency keys every idempotencyKeysJobInterval until ctx is done.
+func (server patientsServer) runIdempotencyKeysJob(ctx context.Context) {
+	ticker := time.NewTicker(idempotencyKeysJobInterval)
+	defer ticker.Stop()
+	for {
+		deleted, err := server.deleteExpiredIdempotencyKeys(ctx)
+		if err != nil {
+			zap.L().Error("Failed to delete expired idempotency keys", zap.Error(err))
+		} else if deleted > 0 {
+			zap.L().Info("Deleted expired idempotency keys", zap.Int64("count", deleted))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// getIdempotencyKeyTTL returns how long idempotency keys are kept from IDEMPOTENCY_KEY_TTL_HOURS environment variable.
+func getIdempotencyKeyTTL() (time.Duration, error) {
+	value := ms.GetOptionalEnv(envIdempotencyKeyTTLHours, defaultIdempotencyKeyTTLHours)
+	hours, err := strconv.Atoi(value)
+	if err != nil || hours <= 0 {
+		return 0, fmt.Errorf("%s environment variable has to be a positive integer", envIdempotencyKeyTTLHours)
+	}
+	return time.Duration(hours) * time.Hour, nil
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	ppb "github.com/TekClinic/Patients-MicroService/patients_protobuf"
+	"github.com/go-playground/validator/v10"
+	sf "github.com/sa-/slicefunk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	insuranceProviderClalit   = "clalit"
+	insuranceProviderMaccabi  = "maccabi"
+	insuranceProviderMeuhedet = "meuhedet"
+	insuranceProviderLeumit   = "leumit"
+	insuranceProviderPrivate  = "private"
+	insuranceProviderOther    = "other"
+
+	// Rules reported by validateInsurance as the failed validation tags, besides rules of personal IDs.
+	insuranceProviderRule       = "insurance_provider"
+	privateMemberNumberRule     = "private_member_number"
+	otherMemberNumberLengthRule = "member_number_length"
+
+	maxOtherMemberNumberLength = 50
+	// maxInsurances is the maximum number of insurances of a patient.
+	maxInsurances = 10
+
+	insuranceNotFoundMessage = "insurance is not found"
+)
+
+// privateMemberNumberPattern is a pattern of member numbers of private insurers.
+var privateMemberNumberPattern = regexp.MustCompile(`^[A-Z0-9]{4,30}$`)
+
+// insuranceProviders returns the registry of known insurance providers by their names.
+// Israeli HMOs (kupot holim) identify their members by the Israeli ID of the member,
+// so member numbers of HMOs are normalized and validated as Israeli IDs.
+func insuranceProviders() map[string]identifierType {
+	israeliID := identifierType{
+		normalize: normalizeIsraeliID,
+		validate:  validateIsraeliID,
+	}
+	return map[string]identifierType{
+		insuranceProviderClalit:   israeliID,
+		insuranceProviderMaccabi:  israeliID,
+		insuranceProviderMeuhedet: israeliID,
+		insuranceProviderLeumit:   israeliID,
+		insuranceProviderPrivate: {
+			normalize: normalizeIdentifier,
+			validate: func(_ *validator.Validate, memberNumber string) string {
+				if !privateMemberNumberPattern.MatchString(memberNumber) {
+					return privateMemberNumberRule
+				}
+				return ""
+			},
+		},
+		insuranceProviderOther: {
+			normalize: strings.TrimSpace,
+			validate: func(_ *validator.Validate, memberNumber string) string {
+				if len(memberNumber) > maxOtherMemberNumberLength {
+					return otherMemberNumberLengthRule
+				}
+				return ""
+			},
+		},
+	}
+}
+
+// AddInsurance adds a health insurance membership to a patient with the given id.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:write permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If the insurance is not valid or the patient already has the maximum number of insurances,
+// codes.InvalidArgument is returned.
+// If a patient with a given id doesn't exist, codes.NotFound is returned.
+func (server patientsServer) AddInsurance(ctx context.Context, req *ppb.AddInsuranceRequest) (
+	*ppb.AddInsuranceResponse, error) {
+	insurance, err := insuranceFromGRPC(req.GetInsurance())
+	if err != nil {
+		return nil, err
+	}
+	insurance.ID = 0
+	if err = server.validate.Struct(insurance); err != nil {
+		return nil, server.validationError(ctx, "insurance", err)
+	}
+
+	err = server.changePatientRecords(ctx, req.GetPatientId(), func(patient *Patient) error {
+		if limitErr := server.checkRecordsLimit(ctx, "insurance", "insurances", len(patient.Insurances),
+			maxInsurances); limitErr != nil {
+			return limitErr
+		}
+		patient.Insurances = append(patient.Insurances, insurance)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ppb.AddInsuranceResponse{Id: insurance.ID}, nil
+}
+
+// UpdateInsurance replaces a health insurance membership of a patient with the given id by the given one.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:write permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If the insurance id is not set or the insurance is not valid, codes.InvalidArgument is returned.
+// If a patient or its insurance with given ids don't exist, codes.NotFound is returned.
+func (server patientsServer) UpdateInsurance(ctx context.Context, req *ppb.UpdateInsuranceRequest) (
+	*ppb.UpdateInsuranceResponse, error) {
+	insurance, err := insuranceFromGRPC(req.GetInsurance())
+	if err != nil {
+		return nil, err
+	}
+	if insurance.ID == 0 {
+		return nil, fieldViolationError("insurance.id", "insurance id is required")
+	}
+	if err = server.validate.Struct(insurance); err != nil {
+		return nil, server.validationError(ctx, "insurance", err)
+	}
+
+	err = server.changePatientRecords(ctx, req.GetPatientId(), func(patient *Patient) error {
+		for i, previous := range patient.Insurances {
+			if previous.ID == insurance.ID {
+				patient.Insurances[i] = insurance
+				return nil
+			}
+		}
+		return status.Error(codes.NotFound, insuranceNotFoundMessage)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ppb.UpdateInsuranceResponse{Id: insurance.ID}, nil
+}
+
+// RemoveInsurance deletes a health insurance membership of a patient with the given id.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:write permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If a patient or its insurance with given ids don't exist, codes.NotFound is returned.
+func (server patientsServer) RemoveInsurance(ctx context.Context, req *ppb.RemoveInsuranceRequest) (
+	*ppb.RemoveInsuranceResponse, error) {
+	err := server.changePatientRecords(ctx, req.GetPatientId(), func(patient *Patient) error {
+		remaining := sf.Filter(patient.Insurances, func(insurance *Insurance) bool {
+			return insurance.ID != req.GetId()
+		})
+		if len(remaining) == len(patient.Insurances) {
+			return status.Error(codes.NotFound, insuranceNotFoundMessage)
+		}
+		patient.Insurances = remaining
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ppb.RemoveInsuranceResponse{}, nil
+}
+
+// insuranceFromGRPC returns an Insurance from a GRPC version, with its provider and member number
+// in their canonical forms. An empty member number is left empty, so it fails validation as required
+// instead of being normalized into a number of a provider. If a date is not valid, codes.InvalidArgument is returned.
+func insuranceFromGRPC(insurance *ppb.Patient_Insurance) (*Insurance, error) {
+	validFrom, err := parseOptionalDate(insurance.GetValidFrom())
+	if err != nil {
+		return nil, fieldViolationError("insurance.valid_from", fmt.Sprintf("failed to parse valid from date: %v", err))
+	}
+	validTo, err := parseOptionalDate(insurance.GetValidTo())
+	if err != nil {
+		return nil, fieldViolationError("insurance.valid_to", fmt.Sprintf("failed to parse valid to date: %v", err))
+	}
+
+	provider := strings.ToLower(strings.TrimSpace(insurance.GetProvider()))
+	memberNumber := strings.TrimSpace(insurance.GetMemberNumber())
+	if providerType, known := insuranceProviders()[provider]; known && memberNumber != "" {
+		memberNumber = providerType.normalize(memberNumber)
+	}
+	return &Insurance{
+		ID:           insurance.GetId(),
+		Provider:     provider,
+		MemberNumber: memberNumber,
+		Plan:         strings.TrimSpace(insurance.GetPlan()),
+		ValidFrom:    validFrom,
+		ValidTo:      validTo,
+	}, nil
+}
+
+// validateInsurance is a struct level validation of Insurance.
+// It reports the provider if it is not known, or the member number with the rule of its provider
+// that the member number breaks.
+func validateInsurance(sl validator.StructLevel) {
+	insurance, _ := sl.Current().Interface().(Insurance)
+	if insurance.Provider == "" {
+		return
+	}
+	provider, known := insuranceProviders()[insurance.Provider]
+	if !known {
+		sl.ReportError(insurance.Provider, protoFieldName("Provider"), "Provider", insuranceProviderRule, "")
+		return
+	}
+	if insurance.MemberNumber == "" {
+		return
+	}
+	if rule := provider.validate(sl.Validator(), insurance.MemberNumber); rule != "" {
+		sl.ReportError(insurance.MemberNumber, protoFieldName("MemberNumber"), "MemberNumber", rule,
+			insurance.Provider)
+	}
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	ppb "github.com/TekClinic/Patients-MicroService/patients_protobuf"
+	sf "github.com/sa-/slicefunk"
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// medicationRouteTag is the validation tag of medication routes, an alias of oneof medicationRoutes.
+	medicationRouteTag = "medication_route"
+	// medicationRoutes are the known routes of administration of medications, separated by spaces.
+	medicationRoutes = "oral sublingual inhaled nasal topical transdermal ophthalmic otic " +
+		"intravenous intramuscular subcutaneous rectal other"
+
+	medicationNotFoundMessage = "medication is not found"
+)
+
+// AddMedication adds an active medication to a patient with the given id.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:write permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If the medication is not valid or the patient already has the maximum number of medications,
+// codes.InvalidArgument is returned.
+// If a patient with a given id doesn't exist, codes.NotFound is returned.
+func (server patientsServer) AddMedication(ctx context.Context, req *ppb.AddMedicationRequest) (
+	*ppb.AddMedicationResponse, error) {
+	medication, err := medicationFromGRPC(req.GetMedication())
+	if err != nil {
+		return nil, err
+	}
+	medication.ID = 0
+	medication.Active = true
+	if err = server.validate.Struct(medication); err != nil {
+		return nil, server.validationError(ctx, "medication", err)
+	}
+
+	err = server.changePatientRecords(ctx, req.GetPatientId(), func(patient *Patient) error {
+		if limitErr := server.checkRecordsLimit(ctx, "medication", "medications", len(patient.Medications),
+			maxClinicalRecords); limitErr != nil {
+			return limitErr
+		}
+		patient.Medications = append(patient.Medications, medication)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ppb.AddMedicationResponse{Id: medication.ID}, nil
+}
+
+// UpdateMedication replaces a medication of a patient with the given id by the given medication.
+// A medication is stopped by setting its stop date and clearing its active flag.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:write permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If the medication id is not set or the medication is not valid, codes.InvalidArgument is returned.
+// If a patient or its medication with given ids don't exist, codes.NotFound is returned.
+func (server patientsServer) UpdateMedication(ctx context.Context, req *ppb.UpdateMedicationRequest) (
+	*ppb.UpdateMedicationResponse, error) {
+	medication, err := medicationFromGRPC(req.GetMedication())
+	if err != nil {
+		return nil, err
+	}
+	if medication.ID == 0 {
+		return nil, fieldViolationError("medication.id", "medication id is required")
+	}
+	if err = server.validate.Struct(medication); err != nil {
+		return nil, server.validationError(ctx, "medication", err)
+	}
+
+	err = server.changePatientRecords(ctx, req.GetPatientId(), func(patient *Patient) error {
+		for i, previous := range patient.Medications {
+			if previous.ID == medication.ID {
+				patient.Medications[i] = medication
+				return nil
+			}
+		}
+		return status.Error(codes.NotFound, medicationNotFoundMessage)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ppb.UpdateMedicationResponse{Id: medication.ID}, nil
+}
+
+// RemoveMedication deletes a medication of a patient with the given id, e.g. if it was recorded by mistake.
+// Medications that the patient stopped taking should be updated as inactive instead.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:write permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If a patient or its medication with given ids don't exist, codes.NotFound is returned.
+func (server patientsServer) RemoveMedication(ctx context.Context, req *ppb.RemoveMedicationRequest) (
+	*ppb.RemoveMedicationResponse, error) {
+	err := server.changePatientRecords(ctx, req.GetPatientId(), func(patient *Patient) error {
+		remaining := sf.Filter(patient.Medications, func(medication *Medication) bool {
+			return medication.ID != req.GetId()
+		})
+		if len(remaining) == len(patient.Medications) {
+			return status.Error(codes.NotFound, medicationNotFoundMessage)
+		}
+		patient.Medications = remaining
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ppb.RemoveMedicationResponse{}, nil
+}
+
+// ListMedications returns active medications of a patient with the given id, and inactive ones if requested.
+// If the patient was merged into another patient, records of the other patient are returned, like in GetPatient.
+// Access to the patient is recorded in the access log.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:read permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If a patient with a given id doesn't exist, codes.NotFound is returned.
+func (server patientsServer) ListMedications(ctx context.Context, req *ppb.ListMedicationsRequest) (
+	*ppb.ListMedicationsResponse, error) {
+	var filter func(query *bun.SelectQuery) *bun.SelectQuery
+	if !req.GetIncludeInactive() {
+		filter = func(query *bun.SelectQuery) *bun.SelectQuery {
+			return query.Where("active")
+		}
+	}
+	var medications []*Medication
+	patientID, err := server.fetchClinicalRecords(ctx, req.GetPatientId(), &medications, filter)
+	if err != nil {
+		return nil, err
+	}
+	if err = server.logAccess(ctx, ppb.PatientsService_ListMedications_FullMethodName, patientID); err != nil {
+		return nil, err
+	}
+	return &ppb.ListMedicationsResponse{
+		Results: sf.Map(medications, (*Medication).toGRPC),
+	}, nil
+}
+
+// ExportPatient returns a full record of a patient with the given id: the patient with its emergency contacts,
+// allergies, conditions and insurances, all of its medications and its whole history, most recent revision first.
+// Deleted and merged patients are exported as they are, without following merges.
+// Access to the patient is recorded in the access log.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:read permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If a patient with a given id doesn't exist, codes.NotFound is returned.
+func (server patientsServer) ExportPatient(ctx context.Context, req *ppb.ExportPatientRequest) (
+	*ppb.ExportPatientResponse, error) {
+	patient, err := server.fetchPatient(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err = fetchMedications(ctx, server.db, patient); err != nil {
+		return nil, err
+	}
+	if err = server.resolveLinkedGuardians(ctx, patient); err != nil {
+		return nil, err
+	}
+
+	var revisions []PatientRevision
+	err = server.db.NewSelect().
+		Model(&revisions).
+		Where("patient_id = ?", patient.ID).
+		OrderExpr("created_at DESC, id DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to fetch patient history: %w", err).Error())
+	}
+
+	if err = server.logAccess(ctx, ppb.PatientsService_ExportPatient_FullMethodName, patient.ID); err != nil {
+		return nil, err
+	}
+	return &ppb.ExportPatientResponse{
+		Patient:     patient.toGRPC(),
+		Medications: sf.Map(patient.Medications, (*Medication).toGRPC),
+		Revisions:   sf.Map(revisions, PatientRevision.toGRPC),
+		ExportedAt:  timestamppb.Now(),
+	}, nil
+}
+
+// fetchMedications fetches all medications of a patient into the patient, ordered by their ids.
+// GetPatient doesn't return medications, so they are fetched only where they are exported or changed.
+func fetchMedications(ctx context.Context, db bun.IDB, patient *Patient) error {
+	err := db.NewSelect().
+		Model(&patient.Medications).
+		Where("patient_id = ?", patient.ID).
+		Order("id").
+		Scan(ctx)
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Errorf("failed to fetch medications: %w", err).Error())
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	ppb "github.com/TekClinic/Patients-MicroService/patients_protobuf"
+	sf "github.com/sa-/slicefunk"
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// MergePatients merges a source patient into a target patient, e.g. to consolidate duplicates.
+// Fields of the target are replaced with the fields of the source that are chosen by the resolution,
+// other fields keep their target values. Emergency contacts, guardians, allergies, conditions, medications
+// and insurances of the source are moved to the target, and guardians linking to the source link to the target.
+// The source is deleted and points at the target, so GetPatient of the source returns the target.
+// Patients that were merged into the source before point at the target as well.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:delete permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If ids or versions are missing, the resolution contains unknown or read-only fields,
+// or the merged patient is not valid, codes.InvalidArgument is returned.
+// If the source or the target doesn't exist, codes.NotFound is returned.
+// The versions of the patients have to match the stored ones, otherwise some of them was modified after
+// the client has read it and codes.Aborted is returned.
+func (server patientsServer) MergePatients(ctx context.Context, req *ppb.MergePatientsRequest) (
+	*ppb.MergePatientsResponse, error) {
+	if req.GetSourceId() == 0 || req.GetTargetId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "source and target IDs are required")
+	}
+	if req.GetSourceId() == req.GetTargetId() {
+		return nil, status.Error(codes.InvalidArgument, "patient can't be merged into itself")
+	}
+	if req.GetSourceVersion() == 0 || req.GetTargetVersion() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "source and target versions are required")
+	}
+	paths, err := mergeSourcePaths(req.GetResolution())
+	if err != nil {
+		return nil, err
+	}
+
+	var merged Patient
+	if err = server.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		source, target, txErr := lockMergedPatients(ctx, tx, req)
+		if txErr != nil {
+			return txErr
+		}
+		// medications of both patients are part of the merged patient and of its revision
+		if txErr = fetchMedications(ctx, tx, source); txErr != nil {
+			return txErr
+		}
+		if txErr = fetchMedications(ctx, tx, target); txErr != nil {
+			return txErr
+		}
+
+		mergedGRPC := target.toGRPC()
+		applyFieldMask(mergedGRPC, source.toGRPC(), paths)
+		merged, txErr = patientFromGRPC(mergedGRPC)
+		if txErr != nil {
+			return status.Error(codes.InvalidArgument, txErr.Error())
+		}
+		merged.EmergencyContacts = append(append([]*EmergencyContact{}, target.EmergencyContacts...),
+			source.EmergencyContacts...)
+		merged.Allergies = append(append([]*Allergy{}, target.Allergies...), source.Allergies...)
+		merged.Conditions = append(append([]*Condition{}, target.Conditions...), source.Conditions...)
+		merged.Medications = append(append([]*Medication{}, target.Medications...), source.Medications...)
+		merged.Insurances = append(append([]*Insurance{}, target.Insurances...), source.Insurances...)
+		// guardians linking one of the merged patients to the other would link the target to itself
+		merged.Guardians = sf.Filter(append(append([]*Guardian{}, target.Guardians...), source.Guardians...),
+			func(guardian *Guardian) bool {
+				return guardian.LinkedPatientID != source.ID && guardian.LinkedPatientID != target.ID
+			})
+		if txErr = server.validate.Struct(merged); txErr != nil {
+			return server.validationError(ctx, "", txErr)
+		}
+		// merging the only guardian of the target into it mustn't leave the target without guardians
+		if txErr = server.checkGuardiansRequired(ctx, "", &merged); txErr != nil {
+			return txErr
+		}
+
+		// the source is deleted first, so the target can take over its personal ID
+		if txErr = deleteMergedPatient(ctx, tx, source, target.ID); txErr != nil {
+			return txErr
+		}
+		merged.Version++
+		return savePatient(ctx, tx, "", target, &merged, false, ppb.PatientRevision_MERGE)
+	}); err != nil {
+		return nil, toStatusError(personalIDConflictError(ctx, server.db, &merged, err))
+	}
+	return &ppb.MergePatientsResponse{Id: merged.ID, Version: merged.Version}, nil
+}
+
+// mergeSourcePaths returns the field paths of a patient whose values are taken from the source patient
+// according to the resolution of a merge.
+// If the resolution contains unknown or read-only fields, codes.InvalidArgument is returned.
+func mergeSourcePaths(resolution map[string]ppb.MergePatientsRequest_Side) ([]string, error) {
+	mask := &fieldmaskpb.FieldMask{}
+	for path, side := range resolution {
+		switch side {
+		case ppb.MergePatientsRequest_TARGET:
+		case ppb.MergePatientsRequest_SOURCE:
+			mask.Paths = append(mask.Paths, path)
+		default:
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown resolution of field %s", path))
+		}
+	}
+	if !mask.IsValid(&ppb.Patient{}) {
+		return nil, status.Error(codes.InvalidArgument, "resolution contains unknown fields")
+	}
+	mask.Normalize()
+
+	readOnly := readOnlyPatientFields()
+	for _, path := range mask.GetPaths() {
+		field, _, _ := strings.Cut(path, fieldPathSeparator)
+		// emergency contacts and guardians of both patients are always kept
+		if _, exists := readOnly[field]; exists || field == emergencyContactsField || field == guardiansField {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("field %s can't be resolved", field))
+		}
+	}
+	return mask.GetPaths(), nil
+}
+
+// lockMergedPatients locks the source and the target patients of a merge and checks their versions.
+// Patients are locked in the order of their ids, so concurrent merges of the same patients don't deadlock.
+func lockMergedPatients(ctx context.Context, tx bun.Tx, req *ppb.MergePatientsRequest) (*Patient, *Patient, error) {
+	if req.GetSourceId() < req.GetTargetId() {
+		source, err := lockPatientVersion(ctx, tx, req.GetSourceId(), req.GetSourceVersion())
+		if err != nil {
+			return nil, nil, err
+		}
+		target, err := lockPatientVersion(ctx, tx, req.GetTargetId(), req.GetTargetVersion())
+		return source, target, err
+	}
+	target, err := lockPatientVersion(ctx, tx, req.GetTargetId(), req.GetTargetVersion())
+	if err != nil {
+		return nil, nil, err
+	}
+	source, err := lockPatientVersion(ctx, tx, req.GetSourceId(), req.GetSourceVersion())
+	return source, target, err
+}
+
+// deleteMergedPatient deletes a locked source patient of a merge, pointing it and the patients that were merged
+// into it at the target patient, and moves its emergency contacts, guardians and clinical records to the target
+// patient. Guardians linking to the source are linked to the target instead.
+func deleteMergedPatient(ctx context.Context, tx bun.Tx, source *Patient, targetID int32) error {
+	models := []interface{}{
+		(*EmergencyContact)(nil), (*Guardian)(nil), (*Allergy)(nil), (*Condition)(nil), (*Medication)(nil),
+		(*Insurance)(nil),
+	}
+	for _, model := range models {
+		_, err := tx.NewUpdate().
+			Model(model).
+			Set("patient_id = ?", targetID).
+			Where("patient_id = ?", source.ID).
+			Exec(ctx)
+		if err != nil {
+			return status.Error(codes.Internal, fmt.Errorf("failed to move patient records: %w", err).Error())
+		}
+	}
+	if err := redirectGuardianLinks(ctx, tx, source.ID, targetID); err != nil {
+		return err
+	}
+
+	// merges are flattened, so every merged patient points directly at a patient that is not merged
+	_, err := tx.NewUpdate().
+		Model((*Patient)(nil)).
+		Set("merged_into = ?", targetID).
+		Where("merged_into = ?", source.ID).
+		WhereAllWithDeleted().
+		Exec(ctx)
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Errorf("failed to redirect merged patients: %w", err).Error())
+	}
+
+	merged := *source
+	merged.EmergencyContacts = nil
+	merged.Guardians = nil
+	merged.Allergies = nil
+	merged.Conditions = nil
+	merged.Medications = nil
+	merged.Insurances = nil
+	merged.MergedInto = targetID
+	merged.Version++
+	_, err = tx.NewUpdate().
+		Model((*Patient)(nil)).
+		Set("merged_into = ?", merged.MergedInto).
+		Set("version = ?", merged.Version).
+		Set("deleted_at = current_timestamp").
+		Where("id = ?", merged.ID).
+		Exec(ctx)
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Errorf("failed to delete a merged patient: %w", err).Error())
+	}
+	return recordRevision(ctx, tx, source.ID, ppb.PatientRevision_MERGE, source, &merged)
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	ppb "github.com/TekClinic/Patients-MicroService/patients_protobuf"
+	sf "github.com/sa-/slicefunk"
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const invalidPageTokenMessage = "page token is not valid for this query"
+
+// pageCursor is a position in an ordered list of patients, encoded into opaque page tokens.
+// It points at the last patient of a page, so the next page starts right after it
+// regardless of patients that were added or removed in the meantime.
+type pageCursor struct {
+	// Query is a fingerprint of the query the cursor belongs to
+	Query string `json:"q"`
+	// Key is the sort key of the last patient as text, empty if patients are ordered by id only
+	Key string `json:"k,omitempty"`
+	// ID is the id of the last patient
+	ID int32 `json:"i"`
+}
+
+// patientsQuery describes a paginated query of patients.
+type patientsQuery struct {
+	search    string
+	filter    *ppb.PatientFilter
+	order     *ppb.PatientOrder
+	limit     int32
+	offset    int32
+	pageToken string
+	skipCount bool
+}
+
+// patientsPage is a page of patients' ids returned by a patientsQuery.
+type patientsPage struct {
+	ids []int32
+	// count is the total number of matching patients, zero if the count was skipped
+	count int
+	// nextPageToken points at the next page, empty if there are no more patients
+	nextPageToken string
+}
+
+// findPatients returns a page of patients' ids matching the query.
+// Pagination is done either by offset or by page token, which can't be combined.
+// If some query parameter is not valid, codes.InvalidArgument is returned.
+func (server patientsServer) findPatients(ctx context.Context, query patientsQuery) (patientsPage, error) {
+	if query.pageToken != "" && query.offset != 0 {
+		return patientsPage{}, status.Error(codes.InvalidArgument, "page token can't be combined with offset")
+	}
+	order, err := newPatientOrder(query.order, query.search)
+	if err != nil {
+		return patientsPage{}, err
+	}
+	fingerprint := queryFingerprint(query.search, query.filter, query.order)
+
+	baseQuery, err := applyPatientFilter(server.db.NewSelect().Model((*Patient)(nil)).Column("id"), query.filter)
+	if err != nil {
+		return patientsPage{}, err
+	}
+	baseQuery = applyPatientSearch(baseQuery, query.search)
+
+	var page patientsPage
+	// count before the cursor restricts the query, so it includes patients of all pages
+	if !query.skipCount {
+		if page.count, err = baseQuery.Count(ctx); err != nil {
+			return patientsPage{}, status.Error(codes.Internal, fmt.Errorf("failed to count patients: %w", err).Error())
+		}
+	}
+	if query.pageToken != "" {
+		cursor, cursorErr := decodePageCursor(query.pageToken, fingerprint)
+		if cursorErr != nil {
+			return patientsPage{}, cursorErr
+		}
+		baseQuery = order.after(baseQuery, cursor)
+	}
+
+	// fetch a single extra row to know whether there is a next page
+	var rows []patientRow
+	err = order.apply(order.selectSortKey(baseQuery)).
+		Offset(int(query.offset)).
+		Limit(int(query.limit)+1).
+		Scan(ctx, &rows)
+	if err != nil {
+		return patientsPage{}, status.Error(codes.Internal, fmt.Errorf("failed to fetch patients: %w", err).Error())
+	}
+	if len(rows) > int(query.limit) {
+		rows = rows[:query.limit]
+		last := rows[len(rows)-1]
+		page.nextPageToken = pageCursor{Query: fingerprint, Key: last.SortKey, ID: last.ID}.encode()
+	}
+	page.ids = sf.Map(rows, func(row patientRow) int32 { return row.ID })
+	return page, nil
+}
+
+// patientRow is a patient id together with its sort key, used to build page cursors.
+type patientRow struct {
+	ID      int32  `bun:"id"`
+	SortKey string `bun:"sort_key"`
+}
+
+// queryFingerprint returns a fingerprint of the search, filter and order of a patients query,
+// so page tokens can't be reused with a different query.
+func queryFingerprint(search string, filter *ppb.PatientFilter, order *ppb.PatientOrder) string {
+	query, _ := proto.MarshalOptions{Deterministic: true}.Marshal(&ppb.GetPatientsIDsRequest{
+		Search:  search,
+		Filter:  filter,
+		OrderBy: order,
+	})
+	hash := sha256.Sum256(query)
+	return hex.EncodeToString(hash[:])
+}
+
+// encode returns an opaque page token of the cursor.
+func (cursor pageCursor) encode() string {
+	token, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(token)
+}
+
+// decodePageCursor returns a cursor encoded in the page token.
+// If the token is malformed or belongs to a query with a different fingerprint, codes.InvalidArgument is returned.
+func decodePageCursor(token string, fingerprint string) (pageCursor, error) {
+	var cursor pageCursor
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return cursor, status.Error(codes.InvalidArgument, invalidPageTokenMessage)
+	}
+	if err = json.Unmarshal(data, &cursor); err != nil || cursor.Query != fingerprint {
+		return cursor, status.Error(codes.InvalidArgument, invalidPageTokenMessage)
+	}
+	return cursor, nil
+}
+
+// selectSortKey adds the sort key of the order to the selected columns of query as sort_key column.
+func (order patientOrder) selectSortKey(query *bun.SelectQuery) *bun.SelectQuery {
+	if order.key == "" {
+		return query
+	}
+	return query.ColumnExpr("(?)::text AS sort_key", bun.Safe(order.key))
+}
+
+// after restricts query to patients that come after the cursor in the order.
+func (order patientOrder) after(query *bun.SelectQuery, cursor pageCursor) *bun.SelectQuery {
+	tieComparison := sqlComparison(order.tieDescending)
+	if order.key == "" {
+		return query.Where("id "+tieComparison+" ?", cursor.ID)
+	}
+	return query.WhereGroup(" AND ", func(query *bun.SelectQuery) *bun.SelectQuery {
+		return query.
+			Where("? "+sqlComparison(order.keyDescending)+" ?::?",
+				bun.Safe(order.key), cursor.Key, bun.Safe(order.keyType)).
+			WhereGroup(" OR ", func(query *bun.SelectQuery) *bun.SelectQuery {
+				return query.
+					Where("? = ?::?", bun.Safe(order.key), cursor.Key, bun.Safe(order.keyType)).
+					Where("id "+tieComparison+" ?", cursor.ID)
+			})
+	})
+}
+
+// sqlComparison returns an SQL operator that selects values after a given one in the order direction.
+func sqlComparison(descending bool) string {
+	if descending {
+		return "<"
+	}
+	return ">"
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	ppb "github.com/TekClinic/Patients-MicroService/patients_protobuf"
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	fieldPathSeparator = "."
+
+	// patientField is the field of the patient in update requests.
+	patientField           = "patient"
+	emergencyContactsField = "emergency_contacts"
+)
+
+// readOnlyPatientFields returns fields of a patient that can't be changed by PatchPatient.
+func readOnlyPatientFields() map[string]struct{} {
+	return map[string]struct{}{
+		"id":      {},
+		"age":     {},
+		"version": {},
+		// allergies, conditions and insurances are changed only by their own RPCs
+		"allergies":  {},
+		"conditions": {},
+		"insurances": {},
+	}
+}
+
+// PatchPatient updates only the fields of a patient with the given id that are listed in the update mask.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:write permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If the update mask is empty or not valid, or the patched patient is not valid, codes.InvalidArgument is returned.
+// If a patient with a given id doesn't exist, codes.NotFound is returned.
+// If another patient with the same personal ID exists, codes.AlreadyExists is returned with its id.
+// The version of the patient has to match the stored one, otherwise the patient was modified after
+// the client has read it and codes.Aborted is returned.
+func (server patientsServer) PatchPatient(ctx context.Context, req *ppb.PatchPatientRequest) (
+	*ppb.PatchPatientResponse, error) {
+	if req.GetPatient().GetId() == 0 {
+		return nil, fieldViolationError(fieldPath(patientField, "id"), "Patient ID is required")
+	}
+	if req.GetPatient().GetVersion() == 0 {
+		return nil, fieldViolationError(fieldPath(patientField, "version"), "Patient version is required")
+	}
+	mask := req.GetUpdateMask()
+	if len(mask.GetPaths()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "update mask is required")
+	}
+	if !mask.IsValid(req.GetPatient()) {
+		return nil, status.Error(codes.InvalidArgument, "update mask contains unknown fields")
+	}
+	mask.Normalize()
+	readOnly := readOnlyPatientFields()
+	saveRelations := false
+	for _, path := range mask.GetPaths() {
+		field, _, _ := strings.Cut(path, fieldPathSeparator)
+		if _, exists := readOnly[field]; exists {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("field %s can't be updated", field))
+		}
+		if field == emergencyContactsField || field == guardiansField {
+			saveRelations = true
+		}
+	}
+
+	var patient Patient
+	if err := server.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		previous, txErr := lockPatientVersion(ctx, tx, req.GetPatient().GetId(), req.GetPatient().GetVersion())
+		if txErr != nil {
+			return txErr
+		}
+
+		merged := previous.toGRPC()
+		applyFieldMask(merged, req.GetPatient(), mask.GetPaths())
+		patient, txErr = patientFromGRPC(merged)
+		if txErr != nil {
+			return fieldViolationError(fieldPath(patientField, "birth_date"), txErr.Error())
+		}
+		if txErr = server.validate.Struct(patient); txErr != nil {
+			return server.validationError(ctx, patientField, txErr)
+		}
+		if !saveRelations {
+			patient.EmergencyContacts, patient.Guardians = previous.EmergencyContacts, previous.Guardians
+		}
+		// allergies, conditions, medications and insurances are changed only by their own RPCs
+		patient.Allergies, patient.Conditions, patient.Medications, patient.Insurances =
+			previous.Allergies, previous.Conditions, previous.Medications, previous.Insurances
+
+		if txErr = server.checkGuardiansRequired(ctx, patientField, &patient); txErr != nil {
+			return txErr
+		}
+		if txErr = server.checkLinkedGuardians(ctx, tx, patientField, previous.Guardians, &patient); txErr != nil {
+			return txErr
+		}
+
+		patient.Version++
+		return savePatient(ctx, tx, patientField, previous, &patient, saveRelations, ppb.PatientRevision_UPDATE)
+	}); err != nil {
+		return nil, toStatusError(personalIDConflictError(ctx, server.db, &patient, err))
+	}
+	return &ppb.PatchPatientResponse{Id: patient.ID, Version: patient.Version}, nil
+}
+
+// applyFieldMask copies the fields listed in paths from src to dst.
+// Fields that are unset in src are cleared in dst. Paths have to be valid for the type of the messages.
+func applyFieldMask(dst proto.Message, src proto.Message, paths []string) {
+	for _, path := range paths {
+		names := strings.Split(path, fieldPathSeparator)
+		dstMessage := dst.ProtoReflect()
+		srcMessage := src.ProtoReflect()
+		for _, name := range names[:len(names)-1] {
+			field := dstMessage.Descriptor().Fields().ByName(protoreflect.Name(name))
+			dstMessage = dstMessage.Mutable(field).Message()
+			srcMessage = srcMessage.Get(field).Message()
+		}
+
+		field := dstMessage.Descriptor().Fields().ByName(protoreflect.Name(names[len(names)-1]))
+		if srcMessage.Has(field) {
+			dstMessage.Set(field, srcMessage.Get(field))
+		} else {
+			dstMessage.Clear(field)
+		}
+	}
+}
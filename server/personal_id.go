@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/uptrace/bun"
+)
+
+const (
+	personalIDTypeIsraeliID       = "israeli_id"
+	personalIDTypePassport        = "passport"
+	personalIDTypeForeignResident = "foreign_resident"
+	personalIDTypeOther           = "other"
+
+	// israeliIDLength is the number of digits of an Israeli ID (Teudat Zehut), including the check digit.
+	// Shorter IDs are padded with leading zeros.
+	israeliIDLength = 9
+	// passportCountrySeparator separates the country code of a passport from its number, e.g. US-A1234567.
+	passportCountrySeparator = "-"
+	// passportCountryTag is the validation tag of passport country codes.
+	passportCountryTag       = "iso3166_1_alpha2"
+	maxOtherPersonalIDLength = 50
+
+	// Rules reported by validatePersonalID as the failed validation tags.
+	personalIDTypeRule        = "personal_id_type"
+	israeliIDDigitsRule       = "israeli_id_digits"
+	israeliIDLengthRule       = "israeli_id_length"
+	israeliIDCheckDigitRule   = "israeli_id_check_digit"
+	israeliIDZeroRule         = "israeli_id_zero"
+	passportFormatRule        = "passport_format"
+	passportCountryRule       = "passport_country"
+	passportNumberRule        = "passport_number"
+	foreignResidentFormatRule = "foreign_resident_format"
+	otherPersonalIDLengthRule = "personal_id_length"
+)
+
+var (
+	// passportNumberPattern is a pattern of passport numbers.
+	passportNumberPattern = regexp.MustCompile(`^[A-Z0-9]{5,9}$`)
+	// foreignResidentIDPattern is a pattern of foreign resident IDs.
+	foreignResidentIDPattern = regexp.MustCompile(`^[A-Z0-9]{5,20}$`)
+)
+
+// identifierType defines how identifiers of a type, e.g. IDs of a personal ID type, are normalized and validated.
+type identifierType struct {
+	// normalize returns the canonical form of an ID, so the same ID is always stored the same way
+	normalize func(id string) string
+	// validate returns the rule that a normalized ID breaks, empty if the ID is valid
+	validate func(validate *validator.Validate, id string) string
+}
+
+// personalIDTypes returns the registry of known personal ID types by their names.
+func personalIDTypes() map[string]identifierType {
+	return map[string]identifierType{
+		personalIDTypeIsraeliID: {
+			normalize: normalizeIsraeliID,
+			validate:  validateIsraeliID,
+		},
+		personalIDTypePassport: {
+			normalize: normalizePassport,
+			validate:  validatePassport,
+		},
+		personalIDTypeForeignResident: {
+			normalize: normalizeIdentifier,
+			validate: func(_ *validator.Validate, id string) string {
+				if !foreignResidentIDPattern.MatchString(id) {
+					return foreignResidentFormatRule
+				}
+				return ""
+			},
+		},
+		personalIDTypeOther: {
+			normalize: strings.TrimSpace,
+			validate: func(_ *validator.Validate, id string) string {
+				if len(id) > maxOtherPersonalIDLength {
+					return otherPersonalIDLengthRule
+				}
+				return ""
+			},
+		},
+	}
+}
+
+// normalized returns the personal ID with its type and ID in their canonical forms.
+// IDs of unknown types are only trimmed.
+func (personalID PersonalID) normalized() PersonalID {
+	normalized := PersonalID{
+		ID:   strings.TrimSpace(personalID.ID),
+		Type: strings.ToLower(strings.TrimSpace(personalID.Type)),
+	}
+	if idType, known := personalIDTypes()[normalized.Type]; known {
+		normalized.ID = idType.normalize(normalized.ID)
+	}
+	return normalized
+}
+
+// validatePersonalID is a struct level validation of PersonalID.
+// It reports the ID as required if it is empty, the type if it is not known,
+// or the ID with the rule of its type that the ID breaks.
+func validatePersonalID(sl validator.StructLevel) {
+	personalID, _ := sl.Current().Interface().(PersonalID)
+	if personalID.ID == "" {
+		sl.ReportError(personalID.ID, protoFieldName("ID"), "ID", "required", "")
+		return
+	}
+	idType, known := personalIDTypes()[personalID.Type]
+	if !known {
+		sl.ReportError(personalID.Type, protoFieldName("Type"), "Type", personalIDTypeRule, "")
+		return
+	}
+	if rule := idType.validate(sl.Validator(), personalID.ID); rule != "" {
+		sl.ReportError(personalID.ID, protoFieldName("ID"), "ID", rule, personalID.Type)
+	}
+}
+
+// normalizeIdentifier returns an identifier in upper case without spaces and dashes.
+func normalizeIdentifier(id string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(id))
+}
+
+// normalizeIsraeliID returns an Israeli ID without spaces and dashes, padded with leading zeros.
+// An empty ID stays empty, so it isn't padded into a valid ID of zeros.
+func normalizeIsraeliID(id string) string {
+	id = normalizeIdentifier(id)
+	if id != "" && len(id) < israeliIDLength {
+		id = strings.Repeat("0", israeliIDLength-len(id)) + id
+	}
+	return id
+}
+
+// normalizePassport returns a passport ID in upper case without spaces, keeping only the dash after the country.
+func normalizePassport(id string) string {
+	id = strings.ToUpper(strings.ReplaceAll(id, " ", ""))
+	if country, number, found := strings.Cut(id, passportCountrySeparator); found {
+		return country + passportCountrySeparator + strings.ReplaceAll(number, passportCountrySeparator, "")
+	}
+	return id
+}
+
+// validateIsraeliID returns the rule that a normalized Israeli ID breaks, empty if the ID is valid.
+// The last digit of an Israeli ID is a check digit, computed with the Luhn algorithm.
+// An ID of zeros only has a valid check digit, but is never issued.
+func validateIsraeliID(_ *validator.Validate, id string) string {
+	const (
+		base     = 10
+		maxDigit = 9
+	)
+	sum := 0
+	for i, char := range id {
+		if char < '0' || char > '9' {
+			return israeliIDDigitsRule
+		}
+		// digits are weighted 1 and 2 alternately, digits of two-digit products are summed
+		digit := int(char-'0') * (i%2 + 1)
+		if digit > maxDigit {
+			digit -= maxDigit
+		}
+		sum += digit
+	}
+	if len(id) != israeliIDLength {
+		return israeliIDLengthRule
+	}
+	if strings.Trim(id, "0") == "" {
+		return israeliIDZeroRule
+	}
+	if sum%base != 0 {
+		return israeliIDCheckDigitRule
+	}
+	return ""
+}
+
+// validatePassport returns the rule that a passport ID breaks, empty if the ID is valid.
+// Passport IDs consist of an ISO 3166-1 alpha-2 country code and the passport number, e.g. US-A1234567.
+func validatePassport(validate *validator.Validate, id string) string {
+	country, number, found := strings.Cut(id, passportCountrySeparator)
+	if !found {
+		return passportFormatRule
+	}
+	if validate.Var(country, passportCountryTag) != nil {
+		return passportCountryRule
+	}
+	if !passportNumberPattern.MatchString(number) {
+		return passportNumberRule
+	}
+	return ""
+}
+
+// normalizePersonalIDs stores personal IDs of all the patients, deleted patients included, in their canonical forms.
+// Personal IDs stored before they were normalized would otherwise fail validation on every update of their patients.
+func normalizePersonalIDs(ctx context.Context, db bun.IDB) error {
+	var patients []Patient
+	err := db.NewSelect().
+		Model(&patients).
+		Column("id", "personal_id_id", "personal_id_type").
+		WhereAllWithDeleted().
+		Scan(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch personal IDs: %w", err)
+	}
+
+	for _, patient := range patients {
+		normalized := patient.PersonalID.normalized()
+		if normalized == patient.PersonalID {
+			continue
+		}
+		patient.PersonalID = normalized
+		_, err = db.NewUpdate().
+			Model(&patient).
+			Column("personal_id_id", "personal_id_type").
+			WherePK().
+			WhereAllWithDeleted().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to normalize the personal ID of patient %d: %w", patient.ID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package main
+
+import "testing"
+
+func TestValidateIsraeliID(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+		rule string
+	}{
+		{name: "valid", id: "123456782", rule: ""},
+		{name: "valid with a check digit of zero", id: "000000018", rule: ""},
+		{name: "valid padded", id: normalizeIsraeliID("18"), rule: ""},
+		{name: "valid with two-digit products", id: "039337423", rule: ""},
+		{name: "invalid check digit", id: "123456789", rule: israeliIDCheckDigitRule},
+		{name: "zeros only", id: "000000000", rule: israeliIDZeroRule},
+		{name: "too long", id: "0123456782", rule: israeliIDLengthRule},
+		{name: "too short", id: "12345678", rule: israeliIDLengthRule},
+		{name: "letters", id: "12345678A", rule: israeliIDDigitsRule},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if rule := validateIsraeliID(nil, test.id); rule != test.rule {
+				t.Errorf("validateIsraeliID(%q) = %q, want %q", test.id, rule, test.rule)
+			}
+		})
+	}
+}
+
+func TestNormalizeIsraeliID(t *testing.T) {
+	tests := []struct {
+		id         string
+		normalized string
+	}{
+		{id: "123456782", normalized: "123456782"},
+		{id: "12-345 678-2", normalized: "123456782"},
+		{id: "18", normalized: "000000018"},
+		{id: "", normalized: ""},
+		{id: " - ", normalized: ""},
+	}
+	for _, test := range tests {
+		if normalized := normalizeIsraeliID(test.id); normalized != test.normalized {
+			t.Errorf("normalizeIsraeliID(%q) = %q, want %q", test.id, normalized, test.normalized)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	ms "github.com/TekClinic/MicroService-Lib"
+	ppb "github.com/TekClinic/Patients-MicroService/patients_protobuf"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	envRetentionDays = "RETENTION_DAYS"
+
+	retentionJobInterval = time.Hour
+	hoursInDay           = 24
+
+	purgeReasonRequest   = "request"
+	purgeReasonRetention = "retention"
+)
+
+// PurgePatient permanently deletes a patient with the given id and all its related data.
+// Both active and soft-deleted patients can be purged. Patients that were merged into the patient are purged as well.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:purge permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If a patient with a given id doesn't exist, codes.NotFound is returned.
+// If the patient is the only guardian of a minor, codes.FailedPrecondition is returned.
+func (server patientsServer) PurgePatient(ctx context.Context, req *ppb.PurgePatientRequest) (
+	*ppb.PurgePatientResponse, error) {
+	err := server.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		return purgePatient(ctx, tx, req.GetId(), purgeReasonRequest)
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "patient is not found")
+		}
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to purge a patient: %w", err).Error())
+	}
+	return &ppb.PurgePatientResponse{}, nil
+}
+
+// purgePatient permanently deletes a patient with the given id and the patients that were merged into it,
+// which are duplicate records of the same person, see erasePatient. Must be called inside a transaction.
+// If a patient with a given id doesn't exist, sql.ErrNoRows is returned.
+// If the patient is the only guardian of a minor, codes.FailedPrecondition is returned, see checkNotOnlyGuardian.
+func purgePatient(ctx context.Context, tx bun.Tx, id int32, reason string) error {
+	if err := checkNotOnlyGuardian(ctx, tx, id); err != nil {
+		return err
+	}
+	if err := erasePatient(ctx, tx, id, reason); err != nil {
+		return err
+	}
+
+	var mergedIDs []int32
+	err := tx.NewSelect().
+		Model((*Patient)(nil)).
+		Column("id").
+		Where("merged_into = ?", id).
+		WhereAllWithDeleted().
+		Scan(ctx, &mergedIDs)
+	if err != nil {
+		return fmt.Errorf("failed to fetch merged patients: %w", err)
+	}
+	// guardians of merged patients were moved to the patient, so merged patients guard no one
+	for _, mergedID := range mergedIDs {
+		if err = erasePatient(ctx, tx, mergedID, reason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// erasePatient permanently deletes a patient with the given id together with all its records, guardians linking
+// to it and its revisions, and records the purge in a PurgeRecord.
+// If a patient with a given id doesn't exist, sql.ErrNoRows is returned.
+func erasePatient(ctx context.Context, tx bun.Tx, id int32, reason string) error {
+	contacts, err := tx.NewDelete().Model((*EmergencyContact)(nil)).Where("patient_id = ?", id).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete emergency contacts: %w", err)
+	}
+	contactsCount, err := contacts.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.NewDelete().Model((*Allergy)(nil)).Where("patient_id = ?", id).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete allergies: %w", err)
+	}
+	if _, err = tx.NewDelete().Model((*Condition)(nil)).Where("patient_id = ?", id).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete conditions: %w", err)
+	}
+	if _, err = tx.NewDelete().Model((*Medication)(nil)).Where("patient_id = ?", id).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete medications: %w", err)
+	}
+	if _, err = tx.NewDelete().Model((*Insurance)(nil)).Where("patient_id = ?", id).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete insurances: %w", err)
+	}
+	// guardians linking to the patient identify it, so they are erased as well
+	_, err = tx.NewDelete().
+		Model((*Guardian)(nil)).
+		Where("patient_id = ?", id).
+		WhereOr("linked_patient_id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete guardians: %w", err)
+	}
+
+	res, err := tx.NewDelete().
+		Model((*Patient)(nil)).
+		Where("id = ?", id).
+		WhereAllWithDeleted().
+		ForceDelete().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete a patient: %w", err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	// revisions contain personal information in their changes, so they are erased as well
+	if _, err = tx.NewDelete().Model((*PatientRevision)(nil)).Where("patient_id = ?", id).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete patient revisions: %w", err)
+	}
+
+	record := PurgeRecord{
+		PatientID:              id,
+		Reason:                 reason,
+		EmergencyContactsCount: int(contactsCount),
+	}
+	if _, err = tx.NewInsert().Model(&record).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record a purge: %w", err)
+	}
+	return nil
+}
+
+// purgeExpiredPatients purges all patients that were soft-deleted longer than retention ago.
+// Patients that were merged into other patients are kept, so their ids keep redirecting to the other patients,
+// and are purged together with the other patients, see purgePatient.
+// Every patient is purged in its own transaction. Returns the number of purged patients.
+func (server patientsServer) purgeExpiredPatients(ctx context.Context, retention time.Duration) (int, error) {
+	var ids []int32
+	err := server.db.NewSelect().
+		Model((*Patient)(nil)).
+		Column("id").
+		WhereDeleted().
+		Where("deleted_at < ?", time.Now().Add(-retention)).
+		Where("merged_into IS NULL").
+		Scan(ctx, &ids)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch expired patients: %w", err)
+	}
+
+	purged := 0
+	for _, id := range ids {
+		err = server.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+			return purgePatient(ctx, tx, id, purgeReasonRetention)
+		})
+		// the patient may be purged concurrently, it is fine to skip it
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		// the patient is purged once the minors it guards have other guardians
+		if status.Code(err) == codes.FailedPrecondition {
+			zap.L().Warn("Skipped purging an expired patient", zap.Int32("id", id), zap.Error(err))
+			continue
+		}
+		if err != nil {
+			return purged, fmt.Errorf("failed to purge patient %d: %w", id, err)
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// runRetentionJob purges expired patients every retentionJobInterval until ctx is done.
+func (server patientsServer) runRetentionJob(ctx context.Context) {
+	ticker := time.NewTicker(retentionJobInterval)
+	defer ticker.Stop()
+	for {
+		purged, err := server.purgeExpiredPatients(ctx, server.retention)
+		if err != nil {
+			zap.L().Error("Failed to purge expired patients", zap.Error(err))
+		} else if purged > 0 {
+			zap.L().Info("Purged expired patients", zap.Int("count", purged))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// getRetention returns the retention period of soft-deleted patients from RETENTION_DAYS environment variable.
+// Zero value means that soft-deleted patients are kept forever.
+func getRetention() (time.Duration, error) {
+	value := ms.GetOptionalEnv(envRetentionDays, "0")
+	days, err := strconv.Atoi(value)
+	if err != nil || days < 0 {
+		return 0, fmt.Errorf("%s environment variable has to be a non-negative integer", envRetentionDays)
+	}
+	return time.Duration(days) * hoursInDay * time.Hour, nil
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	ppb "github.com/TekClinic/Patients-MicroService/patients_protobuf"
+	sf "github.com/sa-/slicefunk"
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// searchHighlightTextExpr is an SQL expression of the text of a patient that search matches are highlighted in.
+// It consists only of the fields that summaries contain, so other fields don't leak into summaries.
+const searchHighlightTextExpr = "concat_ws(' ', name, personal_id_id, phone_number)"
+
+// searchHighlightExpr is an SQL expression of the highlight text of a patient with matches of query highlighted.
+// The text is HTML-escaped before matches are wrapped in <b></b> tags, so the tags are its only markup.
+// Patients that match query only by other fields, by their search key or by similarity have an empty highlight,
+// as ts_headline would return the text without matches.
+const searchHighlightExpr = "CASE WHEN to_tsvector('simple', " + searchHighlightTextExpr + ") @@ query::tsquery " +
+	"THEN ts_headline('simple', replace(replace(replace(replace(" + searchHighlightTextExpr + ", " +
+	"'&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '\"', '&quot;'), query::tsquery) " +
+	"ELSE '' END"
+
+// SearchPatients returns summaries of patients with given filters and pagination.
+// Patients are searched, filtered and ordered exactly as in GetPatientsIDs.
+// Access to every returned patient is recorded in the access log.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:read permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// Offset value is used for pagination. Required be a non-negative value.
+// Limit value is used for pagination. Required to be a positive value.
+// If highlight is set and there is a search term, every summary contains the HTML-escaped name, personal ID
+// and phone number of the patient with search terms wrapped in <b></b> tags, empty if none of them matched.
+func (server patientsServer) SearchPatients(ctx context.Context, req *ppb.SearchPatientsRequest) (
+	*ppb.SearchPatientsResponse, error) {
+	if err := validatePagination(req.GetLimit(), req.GetOffset()); err != nil {
+		return nil, err
+	}
+
+	page, err := server.findPatients(ctx, patientsQuery{
+		search:    req.GetSearch(),
+		filter:    req.GetFilter(),
+		order:     req.GetOrderBy(),
+		limit:     req.GetLimit(),
+		offset:    req.GetOffset(),
+		pageToken: req.GetPageToken(),
+		skipCount: req.GetSkipCount(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	summaries, err := server.fetchPatientSummaries(ctx, page.ids, req.GetSearch(), req.GetHighlight())
+	if err != nil {
+		return nil, err
+	}
+	if err = server.logAccess(ctx, ppb.PatientsService_SearchPatients_FullMethodName, page.ids...); err != nil {
+		return nil, err
+	}
+
+	return &ppb.SearchPatientsResponse{
+		Count:         int32(page.count),
+		Results:       sf.Map(summaries, PatientSummary.toGRPC),
+		NextPageToken: page.nextPageToken,
+	}, nil
+}
+
+// fetchPatientSummaries returns summaries of patients with the given ids, in the order of the ids.
+// If highlight is set and search is not empty, summaries contain the highlighted matches of search.
+func (server patientsServer) fetchPatientSummaries(ctx context.Context, ids []int32, search string,
+	highlight bool) ([]PatientSummary, error) {
+	if len(ids) == 0 {
+		return []PatientSummary{}, nil
+	}
+
+	query := server.db.NewSelect().
+		Model((*Patient)(nil)).
+		Column("id", "name", "personal_id_id", "personal_id_type", "birth_date", "phone_number").
+		Where("id IN (?)", bun.In(ids))
+	if highlight && search != "" {
+		query = withSearchTables(query, search).ColumnExpr(searchHighlightExpr + " AS highlight")
+	}
+	var summaries []PatientSummary
+	if err := query.Scan(ctx, &summaries); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to fetch patient summaries: %w", err).Error())
+	}
+
+	found := make(map[int32]PatientSummary, len(summaries))
+	for _, summary := range summaries {
+		found[summary.ID] = summary
+	}
+	// patients deleted after the page was fetched are skipped
+	ordered := make([]PatientSummary, 0, len(ids))
+	for _, id := range ids {
+		if summary, exists := found[id]; exists {
+			ordered = append(ordered, summary)
+		}
+	}
+	return ordered, nil
+}
@@ -10,6 +10,7 @@ import (
 
 	"go.uber.org/zap"
 
+	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
 	sf "github.com/sa-/slicefunk"
 
@@ -30,6 +31,14 @@ type patientsServer struct {
 	db *bun.DB
 	// use a single instance of Validate, it caches struct info
 	validate *validator.Validate
+	// translators translate validation errors to the languages preferred by clients
+	translators *ut.UniversalTranslator
+	// permissions defines which roles are allowed to call which RPCs
+	permissions rolePermissions
+	// retention defines how long soft-deleted patients are kept before they are purged, zero disables purging
+	retention time.Duration
+	// idempotencyTTL defines how long idempotency keys of CreatePatient are kept
+	idempotencyTTL time.Duration
 }
 
 const (
@@ -40,124 +49,227 @@ const (
 
 	applicationName = "patients"
 
-	permissionDeniedMessage = "You don't have enough permission to access this resource"
+	permissionDeniedMessage       = "You don't have enough permission to access this resource"
+	concurrentModificationMessage = "patient was modified by someone else, reload it and try again"
 
 	maxPaginationLimit = 50
 )
 
 // GetPatient returns a patient that corresponds to the given id.
+// If the patient was merged into another patient, the other patient is returned instead,
+// and the given id is returned as redirected from, so ids of merged patients stay valid.
+// Every successful access is recorded in the access log.
 // Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
-// Requires an admin role. If roles are not sufficient, codes.PermissionDenied is returned.
+// Requires the patients:read permission. If roles are not sufficient, codes.PermissionDenied is returned.
 // If a patient with a given id doesn't exist, codes.NotFound is returned.
 func (server patientsServer) GetPatient(ctx context.Context, req *ppb.GetPatientRequest) (
 	*ppb.GetPatientResponse, error) {
-	claims, err := server.VerifyToken(ctx, req.GetToken())
+	patients, err := server.resolvePatients(ctx, []int32{req.GetId()})
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return nil, err
+	}
+	patient, found := patients[req.GetId()]
+	if !found {
+		return nil, status.Error(codes.NotFound, "patient is not found")
 	}
-	if !claims.HasRole("admin") {
-		return nil, status.Error(codes.PermissionDenied, permissionDeniedMessage)
+	response := &ppb.GetPatientResponse{}
+	if patient.ID != req.GetId() {
+		response.RedirectedFrom = req.GetId()
 	}
 
-	patient := new(Patient)
-	err = server.db.NewSelect().
-		Model(patient).
-		Relation("EmergencyContacts").
-		Where("? = ?", bun.Ident("id"), req.GetId()).
+	if err = server.logAccess(ctx, ppb.PatientsService_GetPatient_FullMethodName, patient.ID); err != nil {
+		return nil, err
+	}
+	response.Patient = patient.toGRPC()
+	return response, nil
+}
+
+// resolvePatients returns patients with the given ids by their ids, as GetPatient returns them.
+// Patients that were merged into other patients are replaced by the other patients,
+// and guardians linked to other patients are resolved, see resolveLinkedGuardians.
+// Ids of patients that don't exist are missing from the result.
+func (server patientsServer) resolvePatients(ctx context.Context, ids []int32) (map[int32]*Patient, error) {
+	patients, err := server.fetchPatients(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var mergedInto []int32
+	for _, patient := range patients {
+		if patient.MergedInto != 0 {
+			mergedInto = append(mergedInto, patient.MergedInto)
+		}
+	}
+	if len(mergedInto) > 0 {
+		targets, fetchErr := server.fetchPatients(ctx, sf.Unique(mergedInto))
+		if fetchErr != nil {
+			return nil, fetchErr
+		}
+		for id, patient := range patients {
+			if patient.MergedInto == 0 {
+				continue
+			}
+			// merges are flattened, so the patient it was merged into is never merged itself
+			if target, exists := targets[patient.MergedInto]; exists {
+				patients[id] = target
+			} else {
+				delete(patients, id)
+			}
+		}
+	}
+
+	resolved := make([]*Patient, 0, len(patients))
+	for _, patient := range patients {
+		resolved = append(resolved, patient)
+	}
+	if err = server.resolveLinkedGuardians(ctx, resolved...); err != nil {
+		return nil, err
+	}
+	return patients, nil
+}
+
+// fetchPatient returns a patient with the given id including its emergency contacts, guardians, allergies,
+// conditions and insurances, even if it is deleted. Medications aren't part of the patient, see fetchMedications.
+// If a patient with a given id doesn't exist, codes.NotFound is returned.
+func (server patientsServer) fetchPatient(ctx context.Context, id int32) (*Patient, error) {
+	patients, err := server.fetchPatients(ctx, []int32{id})
+	if err != nil {
+		return nil, err
+	}
+	patient, found := patients[id]
+	if !found {
+		return nil, status.Error(codes.NotFound, "patient is not found")
+	}
+	return patient, nil
+}
+
+// fetchPatients returns patients with the given ids by their ids, like fetchPatient does.
+// Ids of patients that don't exist are missing from the result.
+func (server patientsServer) fetchPatients(ctx context.Context, ids []int32) (map[int32]*Patient, error) {
+	var patients []*Patient
+	err := server.db.NewSelect().
+		Model(&patients).
+		Relation("EmergencyContacts", orderRelationByID).
+		Relation("Guardians", orderRelationByID).
+		Relation("Allergies", orderRelationByID).
+		Relation("Conditions", orderRelationByID).
+		Relation("Insurances", orderRelationByID).
+		Where("? IN (?)", bun.Ident("patient.id"), bun.In(ids)).
 		WhereAllWithDeleted().
 		Scan(ctx)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, status.Error(codes.NotFound, "patient is not found")
-		}
-		return nil, status.Error(codes.Internal, fmt.Errorf("failed to fetch a patients by id: %w", err).Error())
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to fetch patients by ids: %w", err).Error())
+	}
+
+	found := make(map[int32]*Patient, len(patients))
+	for _, patient := range patients {
+		found[patient.ID] = patient
 	}
-	return &ppb.GetPatientResponse{Patient: patient.toGRPC()}, nil
+	return found, nil
 }
 
 // GetPatientsIDs returns a list of patients' ids with given filters and pagination.
+// Access to every returned patient is recorded in the access log.
 // Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
-// Requires an admin role. If roles are not sufficient, codes.PermissionDenied is returned.
+// Requires the patients:read permission. If roles are not sufficient, codes.PermissionDenied is returned.
 // Offset value is used for pagination. Required be a non-negative value.
 // Limit value is used for pagination. Required to be a positive value.
+// Filter values are combined with the search term, unset filter values are ignored.
+// Results are ordered by the requested field, by search relevance if there is a search term, or by id otherwise.
+// Page token from a previous response can be used instead of offset for keyset pagination.
+// Count is not computed if skip count is set.
 func (server patientsServer) GetPatientsIDs(ctx context.Context,
 	req *ppb.GetPatientsIDsRequest) (*ppb.GetPatientsIDsResponse, error) {
-	claims, err := server.VerifyToken(ctx, req.GetToken())
-	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
-	}
-	if !claims.HasRole("admin") {
-		return nil, status.Error(codes.PermissionDenied, permissionDeniedMessage)
+	if err := validatePagination(req.GetLimit(), req.GetOffset()); err != nil {
+		return nil, err
 	}
 
-	if req.GetOffset() < 0 {
-		return nil, status.Error(codes.InvalidArgument, "offset has to be a non-negative integer")
-	}
-	if req.GetLimit() <= 0 {
-		return nil, status.Error(codes.InvalidArgument, "limit has to be a positive integer")
+	page, err := server.findPatients(ctx, patientsQuery{
+		search:    req.GetSearch(),
+		filter:    req.GetFilter(),
+		order:     req.GetOrderBy(),
+		limit:     req.GetLimit(),
+		offset:    req.GetOffset(),
+		pageToken: req.GetPageToken(),
+		skipCount: req.GetSkipCount(),
+	})
+	if err != nil {
+		return nil, err
 	}
-	if req.GetLimit() > maxPaginationLimit {
-		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("maximum allowed limit values is %d", maxPaginationLimit))
+	if err = server.logAccess(ctx, ppb.PatientsService_GetPatientsIDs_FullMethodName, page.ids...); err != nil {
+		return nil, err
 	}
 
-	var ids []int32
-	baseQuery := server.db.NewSelect().Model((*Patient)(nil)).Column("id")
+	return &ppb.GetPatientsIDsResponse{
+		Count:         int32(page.count),
+		Results:       page.ids,
+		NextPageToken: page.nextPageToken,
+	}, nil
+}
 
-	if req.GetSearch() != "" {
-		// Postgres specific code. Use full-text search to search for patients.
-		baseQuery = baseQuery.
-			TableExpr("replace(websearch_to_tsquery('simple', ?)::text || ' ',''' ',''':*') query", req.GetSearch()).
-			Where("text_searchable @@ query::tsquery", req.GetSearch()).
-			OrderExpr("ts_rank(text_searchable, query::tsquery) DESC")
+// BatchGetPatients returns patients that correspond to the given ids, in the order of the ids.
+// Patients are returned as GetPatient returns them, so patients that were merged into other patients
+// are replaced by the other patients.
+// Ids of patients that don't exist are returned separately instead of failing the whole call.
+// Every successful access is recorded in the access log.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:read permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// Ids are required to contain between 1 and maxPaginationLimit values.
+func (server patientsServer) BatchGetPatients(ctx context.Context, req *ppb.BatchGetPatientsRequest) (
+	*ppb.BatchGetPatientsResponse, error) {
+	ids := sf.Unique(req.GetIds())
+	if len(ids) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ids are required")
+	}
+	if len(ids) > maxPaginationLimit {
+		return nil, status.Error(codes.InvalidArgument,
+			fmt.Sprintf("maximum allowed number of ids is %d", maxPaginationLimit))
 	}
 
-	err = baseQuery.
-		Offset(int(req.GetOffset())).
-		Limit(int(req.GetLimit())).
-		Scan(ctx, &ids)
+	found, err := server.resolvePatients(ctx, ids)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Errorf("failed to fetch patients: %w", err).Error())
+		return nil, err
 	}
-	count, err := baseQuery.Count(ctx)
-	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Errorf("failed to count patients: %w", err).Error())
+	response := &ppb.BatchGetPatientsResponse{}
+	foundIDs := make([]int32, 0, len(found))
+	for _, id := range ids {
+		patient, exists := found[id]
+		if !exists {
+			response.MissingIds = append(response.MissingIds, id)
+			continue
+		}
+		response.Results = append(response.Results, patient.toGRPC())
+		foundIDs = append(foundIDs, patient.ID)
 	}
 
-	return &ppb.GetPatientsIDsResponse{
-		Count:   int32(count),
-		Results: ids,
-	}, nil
+	if err = server.logAccess(ctx, ppb.PatientsService_BatchGetPatients_FullMethodName, foundIDs...); err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
 // CreatePatient creates a patient with the given specifications.
 // Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
-// Requires an admin role. If roles are not sufficient, codes.PermissionDenied is returned.
+// Requires the patients:write permission. If roles are not sufficient, codes.PermissionDenied is returned.
 // If some argument is missing or not valid, codes.InvalidArgument is returned.
+// If another patient with the same personal ID exists, codes.AlreadyExists is returned with its id.
+// If the request has an idempotency key, in the request or in the idempotency-key metadata, retries of the request
+// with the same key return the id of the patient created by the first request instead of creating a new patient.
+// If the key was already used for a different request, codes.InvalidArgument is returned.
 func (server patientsServer) CreatePatient(ctx context.Context,
 	req *ppb.CreatePatientRequest) (*ppb.CreatePatientResponse, error) {
-	claims, err := server.VerifyToken(ctx, req.GetToken())
-	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
-	}
-	if !claims.HasRole("admin") {
-		return nil, status.Error(codes.PermissionDenied, permissionDeniedMessage)
-	}
-
 	birthDate, err := time.Parse(birthDateFormat, req.GetBirthDate())
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument,
-			fmt.Errorf("failed to parse birth date: %w", err).Error())
+		return nil, fieldViolationError("birth_date", fmt.Sprintf("failed to parse birth date: %v", err))
 	}
 
 	patient := Patient{
-		Active: true,
-		Name:   req.GetName(),
-		PersonalID: PersonalID{
-			ID:   req.GetPersonalId().GetId(),
-			Type: req.GetPersonalId().GetType(),
-		},
+		Active:      true,
+		Name:        req.GetName(),
+		PersonalID:  personalIDFromGRPC(req.GetPersonalId()),
 		Gender:      req.GetGender(),
 		PhoneNumber: req.GetPhoneNumber(),
+		Address:     addressFromGRPC(req.GetAddress()),
 		Languages:   req.GetLanguages(),
 		BirthDate:   birthDate,
 		ReferredBy:  req.GetReferredBy(),
@@ -169,18 +281,47 @@ func (server patientsServer) CreatePatient(ctx context.Context,
 					Phone:     contact.GetPhone(),
 				}
 			}),
+		Guardians: sf.Map(req.GetGuardians(), func(guardian *ppb.Patient_Guardian) *Guardian {
+			created := guardianFromGRPC(guardian)
+			created.ID = 0
+			return created
+		}),
 		SpecialNote:     req.GetSpecialNote(),
 		NeedsTranslator: req.GetNeedsTranslator(),
 	}
 	if err = server.validate.Struct(patient); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, server.validationError(ctx, "", err)
+	}
+	if err = server.checkGuardiansRequired(ctx, "", &patient); err != nil {
+		return nil, err
+	}
+	idempotencyKey, err := idempotencyKeyFromRequest(ctx, req)
+	if err != nil {
+		return nil, err
 	}
 	if err = server.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		if idempotencyKey != "" {
+			createdID, txErr := server.claimIdempotencyKey(ctx, tx, idempotencyKey, createPatientRequestHash(req))
+			if txErr != nil {
+				return txErr
+			}
+			// the request is a retry, respond as the first request did
+			if createdID != 0 {
+				patient.ID = createdID
+				return nil
+			}
+		}
+		if txErr := checkPersonalIDAvailable(ctx, tx, &patient); txErr != nil {
+			return txErr
+		}
+		if txErr := server.checkLinkedGuardians(ctx, tx, "", nil, &patient); txErr != nil {
+			return txErr
+		}
 		// firstly, insert the patient itself
 		if _, txErr := tx.NewInsert().Model(&patient).Exec(ctx); txErr != nil {
 			return txErr
 		}
-		// afterward, insert all its emergence contacts
+		// afterward, insert all its emergence contacts and guardians
 		for _, contact := range patient.EmergencyContacts {
 			contact.PatientID = patient.ID
 
@@ -188,8 +329,21 @@ func (server patientsServer) CreatePatient(ctx context.Context,
 				return txErr
 			}
 		}
-		return nil
+		if txErr := saveGuardians(ctx, tx, "", patient.ID, nil, patient.Guardians); txErr != nil {
+			return txErr
+		}
+		if txErr := recordRevision(ctx, tx, patient.ID, ppb.PatientRevision_CREATE, nil, &patient); txErr != nil {
+			return txErr
+		}
+		if idempotencyKey == "" {
+			return nil
+		}
+		return completeIdempotencyKey(ctx, tx, idempotencyKey, patient.ID)
 	}); err != nil {
+		err = personalIDConflictError(ctx, server.db, &patient, err)
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		return nil, status.Error(codes.Internal, fmt.Errorf("failed to create a patient: %w", err).Error())
 	}
 	return &ppb.CreatePatientResponse{Id: patient.ID}, nil
@@ -197,93 +351,322 @@ func (server patientsServer) CreatePatient(ctx context.Context,
 
 // DeletePatient deletes a patient with the given id.
 // Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
-// Requires an admin role. If roles are not sufficient, codes.PermissionDenied is returned.
+// Requires the patients:delete permission. If roles are not sufficient, codes.PermissionDenied is returned.
 // If a patient with a given id doesn't exist, codes.NotFound is returned.
+// If the patient is the only guardian of a minor, codes.FailedPrecondition is returned.
 func (server patientsServer) DeletePatient(ctx context.Context, req *ppb.DeletePatientRequest) (
 	*ppb.DeletePatientResponse, error) {
-	claims, err := server.VerifyToken(ctx, req.GetToken())
-	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
-	}
-	if !claims.HasRole("admin") {
-		return nil, status.Error(codes.PermissionDenied, permissionDeniedMessage)
-	}
-
-	res, err := server.db.NewDelete().Model((*Patient)(nil)).Where("id = ?", req.GetId()).Exec(ctx)
-	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Errorf("failed to delete a patient: %w", err).Error())
-	}
-	// if db supports affected rows count and no rows were affected, return not found
-	rows, err := res.RowsAffected()
-	if err == nil && rows == 0 {
-		return nil, status.Error(codes.NotFound, "patient is not found")
+	if err := server.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		if txErr := checkNotOnlyGuardian(ctx, tx, req.GetId()); txErr != nil {
+			return txErr
+		}
+		res, txErr := tx.NewDelete().Model((*Patient)(nil)).Where("id = ?", req.GetId()).Exec(ctx)
+		if txErr != nil {
+			return status.Error(codes.Internal, fmt.Errorf("failed to delete a patient: %w", txErr).Error())
+		}
+		// if db supports affected rows count and no rows were affected, return not found
+		rows, rowsErr := res.RowsAffected()
+		if rowsErr == nil && rows == 0 {
+			return status.Error(codes.NotFound, "patient is not found")
+		}
+		return recordRevision(ctx, tx, req.GetId(), ppb.PatientRevision_DELETE, nil, nil)
+	}); err != nil {
+		return nil, toStatusError(err)
 	}
 	return &ppb.DeletePatientResponse{}, nil
 }
 
 // UpdatePatient updates a patient with the given id and data.
 // Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
-// Requires an admin role. If roles are not sufficient, codes.PermissionDenied is returned.
+// Requires the patients:write permission. If roles are not sufficient, codes.PermissionDenied is returned.
 // If some argument is missing or not valid, codes.InvalidArgument is returned.
 // If a patient with a given id doesn't exist, codes.NotFound is returned.
+// If another patient with the same personal ID exists, codes.AlreadyExists is returned with its id.
+// The version of the patient has to match the stored one, otherwise the patient was modified after
+// the client has read it and codes.Aborted is returned.
 func (server patientsServer) UpdatePatient(ctx context.Context, req *ppb.UpdatePatientRequest) (
 	*ppb.UpdatePatientResponse, error) {
-	claims, err := server.VerifyToken(ctx, req.GetToken())
-	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, err.Error())
-	}
-	if !claims.HasRole("admin") {
-		return nil, status.Error(codes.PermissionDenied, permissionDeniedMessage)
-	}
-
 	patient, err := patientFromGRPC(req.GetPatient())
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, fieldViolationError(fieldPath(patientField, "birth_date"), err.Error())
 	}
 	if err = server.validate.Struct(patient); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, server.validationError(ctx, patientField, err)
+	}
+	if err = server.checkGuardiansRequired(ctx, patientField, &patient); err != nil {
+		return nil, err
 	}
 
 	if patient.ID == 0 {
-		return nil, status.Error(codes.InvalidArgument, "Patient ID is required")
+		return nil, fieldViolationError(fieldPath(patientField, "id"), "Patient ID is required")
+	}
+	if patient.Version == 0 {
+		return nil, fieldViolationError(fieldPath(patientField, "version"), "Patient version is required")
 	}
 
 	if err = server.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
-		// firstly, update the patient itself
-		res, txErr := tx.NewUpdate().
-			Model(&patient).
-			ExcludeColumn("created_at", "deleted_at").
-			WherePK().
-			Exec(ctx)
+		previous, txErr := lockPatientVersion(ctx, tx, patient.ID, patient.Version)
 		if txErr != nil {
-			return status.Error(codes.Internal, fmt.Errorf("failed to update a patient: %w", txErr).Error())
+			return txErr
+		}
+		if txErr = server.checkLinkedGuardians(ctx, tx, patientField, previous.Guardians, &patient); txErr != nil {
+			return txErr
 		}
+		// allergies, conditions, medications and insurances are changed only by their own RPCs
+		patient.Allergies, patient.Conditions, patient.Medications, patient.Insurances =
+			previous.Allergies, previous.Conditions, previous.Medications, previous.Insurances
+		patient.Version++
+		return savePatient(ctx, tx, patientField, previous, &patient, true, ppb.PatientRevision_UPDATE)
+	}); err != nil {
+		return nil, toStatusError(personalIDConflictError(ctx, server.db, &patient, err))
+	}
+	return &ppb.UpdatePatientResponse{Id: patient.ID, Version: patient.Version}, nil
+}
 
-		// if db supports affected rows count and no rows were affected, return not found
-		rows, rowsErr := res.RowsAffected()
-		if rowsErr == nil && rows == 0 {
-			return status.Error(codes.NotFound, "patient is not found")
+// RestorePatient restores a soft-deleted patient with the given id.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:delete permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// If a deleted patient with a given id doesn't exist, codes.NotFound is returned.
+// If another patient with the same personal ID exists, codes.AlreadyExists is returned with its id.
+// If the patient was merged into another patient, codes.FailedPrecondition is returned.
+func (server patientsServer) RestorePatient(ctx context.Context, req *ppb.RestorePatientRequest) (
+	*ppb.RestorePatientResponse, error) {
+	var deleted Patient
+	if err := server.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		txErr := tx.NewSelect().
+			Model(&deleted).
+			Column("id", "personal_id_id", "personal_id_type", "merged_into").
+			Where("id = ?", req.GetId()).
+			WhereDeleted().
+			For("UPDATE").
+			Scan(ctx)
+		if errors.Is(txErr, sql.ErrNoRows) {
+			return status.Error(codes.NotFound, "deleted patient is not found")
+		}
+		if txErr != nil {
+			return status.Error(codes.Internal, fmt.Errorf("failed to fetch a deleted patient: %w", txErr).Error())
+		}
+		// the patient it was merged into holds its data now, so restoring it would duplicate the patient
+		if deleted.MergedInto != 0 {
+			return status.Error(codes.FailedPrecondition,
+				fmt.Sprintf("patient was merged into patient %d and can't be restored", deleted.MergedInto))
+		}
+		// the patient could have been created again while it was deleted
+		if txErr = checkPersonalIDAvailable(ctx, tx, &deleted); txErr != nil {
+			return txErr
 		}
 
-		// afterward, delete all its emergence contacts
-		_, txErr = tx.NewDelete().Model((*EmergencyContact)(nil)).Where("patient_id = ?", patient.ID).Exec(ctx)
+		_, txErr = tx.NewUpdate().
+			Model((*Patient)(nil)).
+			Set("deleted_at = NULL").
+			Where("id = ?", req.GetId()).
+			WhereDeleted().
+			Exec(ctx)
+		if isPersonalIDConflict(txErr) {
+			return txErr
+		}
 		if txErr != nil {
-			return status.Error(codes.Internal, fmt.Errorf("failed to delete emergency contacts: %w", txErr).Error())
+			return status.Error(codes.Internal, fmt.Errorf("failed to restore a patient: %w", txErr).Error())
 		}
+		return recordRevision(ctx, tx, req.GetId(), ppb.PatientRevision_RESTORE, nil, nil)
+	}); err != nil {
+		return nil, toStatusError(personalIDConflictError(ctx, server.db, &deleted, err))
+	}
+	return &ppb.RestorePatientResponse{Id: req.GetId()}, nil
+}
 
-		// finally, insert all its emergence contacts
-		for _, contact := range patient.EmergencyContacts {
-			contact.PatientID = patient.ID
+// ListDeletedPatients returns a list of soft-deleted patients' ids, most recently deleted first.
+// Access to every returned patient is recorded in the access log.
+// Requires authentication. If authentication is not valid, codes.Unauthenticated is returned.
+// Requires the patients:read permission. If roles are not sufficient, codes.PermissionDenied is returned.
+// Offset value is used for pagination. Required be a non-negative value.
+// Limit value is used for pagination. Required to be a positive value.
+func (server patientsServer) ListDeletedPatients(ctx context.Context,
+	req *ppb.ListDeletedPatientsRequest) (*ppb.ListDeletedPatientsResponse, error) {
+	if err := validatePagination(req.GetLimit(), req.GetOffset()); err != nil {
+		return nil, err
+	}
 
-			if _, txErr = tx.NewInsert().Model(contact).Exec(ctx); txErr != nil {
-				return txErr
-			}
+	var ids []int32
+	baseQuery := server.db.NewSelect().
+		Model((*Patient)(nil)).
+		Column("id").
+		WhereDeleted().
+		OrderExpr("deleted_at DESC, id")
+
+	err := baseQuery.
+		Offset(int(req.GetOffset())).
+		Limit(int(req.GetLimit())).
+		Scan(ctx, &ids)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to fetch deleted patients: %w", err).Error())
+	}
+	count, err := baseQuery.Count(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to count deleted patients: %w", err).Error())
+	}
+	if err = server.logAccess(ctx, ppb.PatientsService_ListDeletedPatients_FullMethodName, ids...); err != nil {
+		return nil, err
+	}
+
+	return &ppb.ListDeletedPatientsResponse{
+		Count:   int32(count),
+		Results: ids,
+	}, nil
+}
+
+// lockPatient fetches the current state of a patient with the given id and locks it until the end of tx.
+// Like fetchPatient, medications of the patient aren't fetched.
+// If a patient with a given id doesn't exist, codes.NotFound is returned.
+func lockPatient(ctx context.Context, tx bun.Tx, id int32) (*Patient, error) {
+	patient := new(Patient)
+	err := tx.NewSelect().
+		Model(patient).
+		Relation("EmergencyContacts", orderRelationByID).
+		Relation("Guardians", orderRelationByID).
+		Relation("Allergies", orderRelationByID).
+		Relation("Conditions", orderRelationByID).
+		Relation("Insurances", orderRelationByID).
+		Where("? = ?", bun.Ident("id"), id).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "patient is not found")
 		}
-		return nil
-	}); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("failed to fetch a patient: %w", err).Error())
+	}
+	return patient, nil
+}
+
+// lockPatientVersion works like lockPatient, but also checks that the patient has the given version.
+// If the version of the patient doesn't match the given one, codes.Aborted is returned.
+func lockPatientVersion(ctx context.Context, tx bun.Tx, id int32, version int32) (*Patient, error) {
+	patient, err := lockPatient(ctx, tx, id)
+	if err != nil {
 		return nil, err
 	}
-	return &ppb.UpdatePatientResponse{Id: patient.ID}, nil
+	if patient.Version != version {
+		return nil, status.Error(codes.Aborted, concurrentModificationMessage)
+	}
+	return patient, nil
+}
+
+// savePatient stores a new state of a locked patient and records the changes from its previous state
+// as a revision with the given action.
+// Emergency contacts and guardians of the patient are saved only if saveRelations is set.
+// The field is the path of the patient in the request, like in validationError.
+// If another patient has stored the same personal ID concurrently, the violation of the unique index
+// is returned as is, so the caller can map it by personalIDConflictError after the transaction.
+func savePatient(ctx context.Context, tx bun.Tx, field string, previous *Patient, patient *Patient,
+	saveRelations bool, action ppb.PatientRevision_Action) error {
+	if patient.PersonalID != previous.PersonalID {
+		if err := checkPersonalIDAvailable(ctx, tx, patient); err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.NewUpdate().
+		Model(patient).
+		ExcludeColumn("created_at", "deleted_at").
+		WherePK().
+		Exec(ctx)
+	if isPersonalIDConflict(err) {
+		return err
+	}
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Errorf("failed to update a patient: %w", err).Error())
+	}
+
+	if saveRelations {
+		if err = saveEmergencyContacts(ctx, tx, field, patient.ID, previous.EmergencyContacts,
+			patient.EmergencyContacts); err != nil {
+			return err
+		}
+		if err = saveGuardians(ctx, tx, field, patient.ID, previous.Guardians, patient.Guardians); err != nil {
+			return err
+		}
+	}
+	return recordRevision(ctx, tx, patient.ID, action, previous, patient)
+}
+
+// saveEmergencyContacts synchronizes stored emergency contacts of a patient with the given ones.
+// Contacts without an id are inserted, contacts with an id are updated in place, so their ids stay stable,
+// and previous contacts that are missing from the given ones are deleted.
+// The field is the path of the patient in the request, like in validationError.
+// If a contact has an id that doesn't belong to the patient, codes.InvalidArgument is returned.
+func saveEmergencyContacts(ctx context.Context, tx bun.Tx, field string, patientID int32,
+	previous []*EmergencyContact, contacts []*EmergencyContact) error {
+	existing := make(map[int32]struct{}, len(previous))
+	for _, contact := range previous {
+		existing[contact.ID] = struct{}{}
+	}
+
+	kept := make([]int32, 0, len(contacts))
+	for i, contact := range contacts {
+		if contact.ID == 0 {
+			continue
+		}
+		if _, exists := existing[contact.ID]; !exists {
+			return fieldViolationError(fieldPath(field, fmt.Sprintf("%s[%d].id", emergencyContactsField, i)),
+				fmt.Sprintf("emergency contact %d doesn't belong to the patient", contact.ID))
+		}
+		delete(existing, contact.ID)
+		kept = append(kept, contact.ID)
+	}
+
+	// firstly, delete all emergency contacts that are not kept
+	query := tx.NewDelete().Model((*EmergencyContact)(nil)).Where("patient_id = ?", patientID)
+	if len(kept) > 0 {
+		query = query.Where("id NOT IN (?)", bun.In(kept))
+	}
+	if _, err := query.Exec(ctx); err != nil {
+		return status.Error(codes.Internal, fmt.Errorf("failed to delete emergency contacts: %w", err).Error())
+	}
+
+	// afterward, update kept emergency contacts and insert new ones
+	for _, contact := range contacts {
+		contact.PatientID = patientID
+
+		var err error
+		if contact.ID == 0 {
+			_, err = tx.NewInsert().Model(contact).Exec(ctx)
+		} else {
+			_, err = tx.NewUpdate().Model(contact).WherePK().Exec(ctx)
+		}
+		if err != nil {
+			return status.Error(codes.Internal, fmt.Errorf("failed to save an emergency contact: %w", err).Error())
+		}
+	}
+	return nil
+}
+
+// toStatusError returns err as is if it is a GRPC status error, otherwise wraps it with codes.Internal.
+func toStatusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// orderRelationByID orders records of a has-many relation of a patient, such as emergency contacts, by their ids.
+func orderRelationByID(query *bun.SelectQuery) *bun.SelectQuery {
+	return query.Order("id")
+}
+
+// validatePagination checks that limit and offset values are valid pagination parameters.
+// Returns codes.InvalidArgument error if they are not.
+func validatePagination(limit int32, offset int32) error {
+	if offset < 0 {
+		return status.Error(codes.InvalidArgument, "offset has to be a non-negative integer")
+	}
+	if limit <= 0 {
+		return status.Error(codes.InvalidArgument, "limit has to be a positive integer")
+	}
+	if limit > maxPaginationLimit {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("maximum allowed limit values is %d", maxPaginationLimit))
+	}
+	return nil
 }
 
 // createPatientsServer initializes a patientsServer with all the necessary fields.
@@ -316,13 +699,34 @@ func createPatientsServer() (*patientsServer, error) {
 		pgdriver.WithDatabase(database),
 		pgdriver.WithApplicationName(applicationName),
 		pgdriver.WithInsecure(!ms.HasSecureConnection()),
+		pgdriver.WithConnParams(map[string]interface{}{searchSimilarityParam: searchSimilarityThreshold}),
 	)
+	permissions, err := parseRolePermissions(ms.GetOptionalEnv(envRolePermissions, defaultRolePermissions))
+	if err != nil {
+		return nil, err
+	}
+	retention, err := getRetention()
+	if err != nil {
+		return nil, err
+	}
+	idempotencyTTL, err := getIdempotencyKeyTTL()
+	if err != nil {
+		return nil, err
+	}
+	validate, translators, err := newValidator()
+	if err != nil {
+		return nil, err
+	}
 	db := bun.NewDB(sql.OpenDB(connector), pgdialect.New())
 	db.AddQueryHook(ms.GetDBQueryHook())
 	return &patientsServer{
 		BaseServiceServer: base,
 		db:                db,
-		validate:          validator.New(validator.WithRequiredStructEnabled())}, nil
+		validate:          validate,
+		translators:       translators,
+		permissions:       permissions,
+		retention:         retention,
+		idempotencyTTL:    idempotencyTTL}, nil
 }
 
 func main() {
@@ -336,12 +740,17 @@ func main() {
 		zap.L().Fatal("Failed to create a schema", zap.Error(err))
 	}
 
+	if service.retention > 0 {
+		go service.runRetentionJob(context.Background())
+	}
+	go service.runIdempotencyKeysJob(context.Background())
+
 	listen, err := net.Listen("tcp", ":"+service.GetPort())
 	if err != nil {
 		zap.L().Fatal("Failed to listen", zap.Error(err))
 	}
 
-	srv := grpc.NewServer(ms.GetGRPCServerOptions()...)
+	srv := grpc.NewServer(append(ms.GetGRPCServerOptions(), grpc.ChainUnaryInterceptor(service.authorize))...)
 	ppb.RegisterPatientsServiceServer(srv, service)
 
 	zap.L().Info("Server listening on :" + service.GetPort())
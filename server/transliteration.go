@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/uptrace/bun"
+)
+
+// searchKeyDroppedLetters are letters that are left out of search keys.
+// These are vowels and letters that are used as vowels in Hebrew, Arabic and Cyrillic scripts,
+// as vowels are usually not written in Hebrew and Arabic names.
+const searchKeyDroppedLetters = "aeiouyw" +
+	"аеёиійоуъыьэюяєїАЕЁИІЙОУЪЫЬЭЮЯЄЇ" +
+	"אויע" +
+	"اأإآءؤئعوىيةی"
+
+// searchKeyLetters returns a table that maps every letter of a search key to the letters of Latin, Cyrillic,
+// Hebrew and Arabic scripts that are transliterated to it.
+// Letters that sound alike or are written alike in some of the scripts are folded into the same letter.
+func searchKeyLetters() map[rune]string {
+	return map[rune]string{
+		'b': "bvбвБВבب",
+		'd': "dдДדدذض",
+		'f': "fpфФпПפףفپ",
+		'g': "gjгГґҐגجغگ",
+		'h': "hхХчЧחהحخهچ",
+		'k': "kcqкКכךקقكک",
+		'l': "lлЛלل",
+		'm': "mмМמםم",
+		'n': "nнНנןن",
+		'r': "rрРרر",
+		's': "sсСшШщЩסשسشص",
+		't': "tтТטתتثط",
+		'z': "zзЗжЖцЦזצץزظ",
+	}
+}
+
+// searchKeyTranslation returns the from and to arguments of an SQL translate call
+// that transliterates letters according to searchKeyLetters and removes searchKeyDroppedLetters.
+func searchKeyTranslation() (string, string) {
+	letters := searchKeyLetters()
+	keys := make([]rune, 0, len(letters))
+	for key := range letters {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	var from, to strings.Builder
+	for _, key := range keys {
+		from.WriteString(letters[key])
+		to.WriteString(strings.Repeat(string(key), len([]rune(letters[key]))))
+	}
+	// translate removes the letters of from that have no counterpart in to
+	from.WriteString(searchKeyDroppedLetters)
+	return from.String(), to.String()
+}
+
+// regexpReplace returns an SQL expression that replaces all matches of pattern in the value expression.
+func regexpReplace(value string, pattern string, replacement string) string {
+	return fmt.Sprintf("regexp_replace(%s, '%s', '%s', 'g')", value, pattern, replacement)
+}
+
+// createSearchKeyFunction creates the patient_search_key SQL function, which converts a name written in any
+// of the supported scripts into a search key, so the same name written in different scripts has the same key.
+// The key is a sequence of words, each of them being a skeleton of consonants of a word of the name.
+// Stored generated columns aren't recomputed when a function they use is replaced, so if the function exists
+// with another body, the search_key column is dropped to be added again with keys of the new function.
+func createSearchKeyFunction(ctx context.Context, db *bun.DB) error {
+	key := "lower(normalize(value, NFD))"
+	// remove diacritics, Hebrew niqqud and Arabic harakat, which are separated from letters by NFD
+	key = regexpReplace(key, `[\u0300-\u036f\u0591-\u05c7\u05f3\u05f4\u0610-\u061a\u064b-\u065f\u0670]`, "")
+	// fold Latin digraphs into single letters, so they are transliterated like the letters of other scripts
+	key = regexpReplace(key, `[ck]h`, "h")
+	key = regexpReplace(key, `([sdtgp])h`, `\1`)
+	key = regexpReplace(key, `t[sz]`, "z")
+	key = regexpReplace(key, `x`, "ks")
+	// transliterate letters of all the scripts and drop vowels, see searchKeyTranslation
+	key = fmt.Sprintf("translate(%s, ?, ?)", key)
+	// separate words by a single space, dropping everything that is not a letter
+	key = regexpReplace(key, `[^a-z]+`, " ")
+	// drop silent h at the end of words, as in Sarah or Moshe written in Hebrew
+	key = regexpReplace(key, `h( |$)`, `\1`)
+	// collapse repeated letters, as in Mohammed
+	key = regexpReplace(key, `([a-z])\1+`, `\1`)
+	key = fmt.Sprintf("btrim(%s)", key)
+
+	from, to := searchKeyTranslation()
+	body := db.Formatter().FormatQuery(" SELECT "+key+" ", from, to)
+
+	var previousBody string
+	err := db.NewRaw("SELECT prosrc FROM pg_proc WHERE proname = 'patient_search_key'").Scan(ctx, &previousBody)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	if err == nil && previousBody != body {
+		if _, err = db.NewRaw("ALTER TABLE patients DROP COLUMN IF EXISTS search_key").Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Postgres specific code. The function is immutable, so it can be used by generated columns.
+	_, err = db.NewRaw("CREATE OR REPLACE FUNCTION patient_search_key(value text) RETURNS text "+
+		"LANGUAGE sql IMMUTABLE PARALLEL SAFE AS $$?$$", bun.Safe(body)).Exec(ctx)
+	return err
+}
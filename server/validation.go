@@ -0,0 +1,375 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	ppb "github.com/TekClinic/Patients-MicroService/patients_protobuf"
+	"github.com/go-playground/locales/ar"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/he"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	enTranslations "github.com/go-playground/validator/v10/translations/en"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// acceptLanguageMetadata is a GRPC metadata key of the languages preferred by the client,
+	// in the format of the Accept-Language HTTP header, e.g. he-IL,he;q=0.9,en;q=0.8.
+	acceptLanguageMetadata = "accept-language"
+	defaultLocale          = "en"
+	// kindSeparator separates a validation tag from a kind of fields in keys of specialized messages.
+	kindSeparator = "-"
+)
+
+// newValidator returns a validator of patients that reports fields by their proto names,
+// together with translators of its errors to English, Hebrew and Arabic.
+func newValidator() (*validator.Validate, *ut.UniversalTranslator, error) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		return protoFieldName(field.Name)
+	})
+	validate.RegisterStructValidation(validatePersonalID, PersonalID{})
+	validate.RegisterStructValidation(validateInsurance, Insurance{})
+	validate.RegisterAlias(medicationRouteTag, "oneof="+medicationRoutes)
+	if err := validate.RegisterValidation(severityTag, isKnownSeverity); err != nil {
+		return nil, nil, err
+	}
+
+	translators := ut.New(en.New(), en.New(), he.New(), ar.New())
+	enTranslator, _ := translators.GetTranslator("en")
+	if err := enTranslations.RegisterDefaultTranslations(validate, enTranslator); err != nil {
+		return nil, nil, err
+	}
+	// Arabic translations of the validator fail on plural forms, so both Hebrew and Arabic are defined here
+	for locale, messages := range map[string][]map[string]string{
+		"en": {enTranslationMessages(), enPersonalIDMessages(), enInsuranceMessages(), enGuardianMessages()},
+		"he": {heTranslationMessages(), hePersonalIDMessages(), heInsuranceMessages(), heGuardianMessages()},
+		"ar": {arTranslationMessages(), arPersonalIDMessages(), arInsuranceMessages(), arGuardianMessages()},
+	} {
+		translator, _ := translators.GetTranslator(locale)
+		for _, localeMessages := range messages {
+			if err := registerTranslations(validate, translator, localeMessages); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	return validate, translators, nil
+}
+
+// registerTranslations registers translations of validation tags to messages.
+// Messages may refer to the field as {0} and to the parameter of the tag as {1}.
+// A message of a tag may be specialized for a kind of fields by a key of the tag and the kind, e.g. max-string.
+func registerTranslations(validate *validator.Validate, translator ut.Translator, messages map[string]string) error {
+	for key, message := range messages {
+		if err := translator.Add(key, message, true); err != nil {
+			return err
+		}
+	}
+	translate := func(translator ut.Translator, fe validator.FieldError) string {
+		key := fe.Tag()
+		if _, specialized := messages[key+kindSeparator+fe.Kind().String()]; specialized {
+			key += kindSeparator + fe.Kind().String()
+		}
+		param := fe.Param()
+		// cross-field tags, e.g. gtefield, refer to another field by its struct name
+		if strings.HasSuffix(fe.ActualTag(), "field") {
+			param = protoFieldName(param)
+		}
+		translated, err := translator.T(key, fe.Field(), param)
+		if err != nil {
+			return fe.Error()
+		}
+		return translated
+	}
+	for tag := range messages {
+		if strings.Contains(tag, kindSeparator) {
+			continue
+		}
+		err := validate.RegisterTranslation(tag, translator, func(ut.Translator) error { return nil }, translate)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// enTranslationMessages returns English messages of the validation tags used by patients
+// that the validator doesn't translate itself, and of the records limit rule, see checkRecordsLimit.
+func enTranslationMessages() map[string]string {
+	return map[string]string{
+		"iso3166_1_alpha2": "{0} must be a valid ISO 3166-1 alpha-2 country code",
+		"gtefield":         "{0} must be on or after {1}",
+		medicationRouteTag: "{0} must be one of: {1}",
+		severityTag:        "{0} must be a known severity",
+		recordsLimitRule:   "{0} can contain at most {1} items",
+	}
+}
+
+// heTranslationMessages returns Hebrew messages of the validation tags used by patients.
+func heTranslationMessages() map[string]string {
+	return map[string]string{
+		"required":         "{0} הוא שדה חובה",
+		"required_with":    "{0} הוא שדה חובה",
+		"required_without": "{0} הוא שדה חובה",
+		"iso3166_1_alpha2": "{0} חייב להיות קוד מדינה תקין לפי ISO 3166-1 alpha-2",
+		"e164":             "{0} חייב להיות מספר טלפון תקין בפורמט E.164",
+		"min":              "{0} חייב להיות {1} או יותר",
+		"min-string":       "{0} חייב להכיל לפחות {1} תווים",
+		"min-slice":        "{0} חייב להכיל לפחות {1} פריטים",
+		"max":              "{0} חייב להיות {1} או פחות",
+		"max-string":       "{0} יכול להכיל לכל היותר {1} תווים",
+		"max-slice":        "{0} יכול להכיל לכל היותר {1} פריטים",
+		"gtefield":         "{0} חייב להיות שווה ל-{1} או אחריו",
+		medicationRouteTag: "{0} חייב להיות אחד מהערכים: {1}",
+		severityTag:        "{0} חייב להיות דרגת חומרה מוכרת",
+		recordsLimitRule:   "{0} יכול להכיל לכל היותר {1} פריטים",
+	}
+}
+
+// arTranslationMessages returns Arabic messages of the validation tags used by patients.
+func arTranslationMessages() map[string]string {
+	return map[string]string{
+		"required":         "{0} حقل مطلوب",
+		"required_with":    "{0} حقل مطلوب",
+		"required_without": "{0} حقل مطلوب",
+		"iso3166_1_alpha2": "يجب أن يكون {0} رمز دولة صالحًا وفق ISO 3166-1 alpha-2",
+		"e164":             "يجب أن يكون {0} رقم هاتف صالح بتنسيق E.164",
+		"min":              "يجب أن يكون {0} {1} أو أكثر",
+		"min-string":       "يجب أن يحتوي {0} على {1} أحرف على الأقل",
+		"min-slice":        "يجب أن يحتوي {0} على {1} عناصر على الأقل",
+		"max":              "يجب أن يكون {0} {1} أو أقل",
+		"max-string":       "يجب ألا يزيد طول {0} عن {1} حرفًا",
+		"max-slice":        "يجب ألا يحتوي {0} على أكثر من {1} عناصر",
+		"gtefield":         "يجب أن يكون {0} مساويًا لـ {1} أو بعده",
+		medicationRouteTag: "يجب أن يكون {0} واحدًا من: {1}",
+		severityTag:        "يجب أن يكون {0} درجة خطورة معروفة",
+		recordsLimitRule:   "يجب ألا يحتوي {0} على أكثر من {1} عناصر",
+	}
+}
+
+// enPersonalIDMessages returns English messages of the personal ID rules, see validatePersonalID.
+func enPersonalIDMessages() map[string]string {
+	return map[string]string{
+		personalIDTypeRule:        "{0} must be a known personal ID type",
+		israeliIDDigitsRule:       "{0} must contain only digits",
+		israeliIDLengthRule:       "{0} must contain 9 digits",
+		israeliIDCheckDigitRule:   "{0} has an invalid check digit",
+		israeliIDZeroRule:         "{0} can't consist of zeros only",
+		passportFormatRule:        "{0} must consist of a country code, a dash and the passport number",
+		passportCountryRule:       "{0} must start with a valid ISO 3166-1 alpha-2 country code",
+		passportNumberRule:        "{0} must have a passport number of 5 to 9 letters and digits",
+		foreignResidentFormatRule: "{0} must contain 5 to 20 letters and digits",
+		otherPersonalIDLengthRule: "{0} must be at most 50 characters long",
+	}
+}
+
+// hePersonalIDMessages returns Hebrew messages of the personal ID rules, see validatePersonalID.
+func hePersonalIDMessages() map[string]string {
+	return map[string]string{
+		personalIDTypeRule:        "{0} חייב להיות סוג מוכר של מספר זהות",
+		israeliIDDigitsRule:       "{0} חייב להכיל ספרות בלבד",
+		israeliIDLengthRule:       "{0} חייב להכיל 9 ספרות",
+		israeliIDCheckDigitRule:   "ספרת הביקורת של {0} שגויה",
+		israeliIDZeroRule:         "{0} לא יכול להכיל אפסים בלבד",
+		passportFormatRule:        "{0} חייב להיות מורכב מקוד מדינה, מקף ומספר הדרכון",
+		passportCountryRule:       "{0} חייב להתחיל בקוד מדינה תקין לפי ISO 3166-1 alpha-2",
+		passportNumberRule:        "מספר הדרכון ב-{0} חייב להכיל 5 עד 9 אותיות וספרות",
+		foreignResidentFormatRule: "{0} חייב להכיל 5 עד 20 אותיות וספרות",
+		otherPersonalIDLengthRule: "{0} יכול להכיל לכל היותר 50 תווים",
+	}
+}
+
+// arPersonalIDMessages returns Arabic messages of the personal ID rules, see validatePersonalID.
+func arPersonalIDMessages() map[string]string {
+	return map[string]string{
+		personalIDTypeRule:        "يجب أن يكون {0} نوعًا معروفًا من أرقام الهوية",
+		israeliIDDigitsRule:       "يجب أن يحتوي {0} على أرقام فقط",
+		israeliIDLengthRule:       "يجب أن يحتوي {0} على 9 أرقام",
+		israeliIDCheckDigitRule:   "رقم التحقق في {0} غير صحيح",
+		israeliIDZeroRule:         "لا يمكن أن يتكون {0} من أصفار فقط",
+		passportFormatRule:        "يجب أن يتكون {0} من رمز الدولة وشرطة ورقم جواز السفر",
+		passportCountryRule:       "يجب أن يبدأ {0} برمز دولة صالح وفق ISO 3166-1 alpha-2",
+		passportNumberRule:        "يجب أن يحتوي رقم جواز السفر في {0} على 5 إلى 9 أحرف وأرقام",
+		foreignResidentFormatRule: "يجب أن يحتوي {0} على 5 إلى 20 حرفًا ورقمًا",
+		otherPersonalIDLengthRule: "يجب ألا يزيد طول {0} عن 50 حرفًا",
+	}
+}
+
+// enInsuranceMessages returns English messages of the insurance rules, see validateInsurance.
+func enInsuranceMessages() map[string]string {
+	return map[string]string{
+		insuranceProviderRule:       "{0} must be a known insurance provider",
+		privateMemberNumberRule:     "{0} must contain 4 to 30 letters and digits",
+		otherMemberNumberLengthRule: "{0} must be at most 50 characters long",
+	}
+}
+
+// heInsuranceMessages returns Hebrew messages of the insurance rules, see validateInsurance.
+func heInsuranceMessages() map[string]string {
+	return map[string]string{
+		insuranceProviderRule:       "{0} חייב להיות גורם ביטוח מוכר",
+		privateMemberNumberRule:     "{0} חייב להכיל 4 עד 30 אותיות וספרות",
+		otherMemberNumberLengthRule: "{0} יכול להכיל לכל היותר 50 תווים",
+	}
+}
+
+// arInsuranceMessages returns Arabic messages of the insurance rules, see validateInsurance.
+func arInsuranceMessages() map[string]string {
+	return map[string]string{
+		insuranceProviderRule:       "يجب أن يكون {0} جهة تأمين معروفة",
+		privateMemberNumberRule:     "يجب أن يحتوي {0} على 4 إلى 30 حرفًا ورقمًا",
+		otherMemberNumberLengthRule: "يجب ألا يزيد طول {0} عن 50 حرفًا",
+	}
+}
+
+// enGuardianMessages returns English messages of the guardian rules, see checkGuardiansRequired.
+func enGuardianMessages() map[string]string {
+	return map[string]string{
+		guardiansRequiredRule: "{0} must contain at least one guardian for patients under 18",
+		guardianPatientRule:   "{0} must be an id of an existing patient",
+		guardianSelfRule:      "{0} can't be the id of the patient itself",
+	}
+}
+
+// heGuardianMessages returns Hebrew messages of the guardian rules, see checkGuardiansRequired.
+func heGuardianMessages() map[string]string {
+	return map[string]string{
+		guardiansRequiredRule: "{0} חייב להכיל לפחות אפוטרופוס אחד עבור מטופלים מתחת לגיל 18",
+		guardianPatientRule:   "{0} חייב להיות מזהה של מטופל קיים",
+		guardianSelfRule:      "{0} לא יכול להיות המזהה של המטופל עצמו",
+	}
+}
+
+// arGuardianMessages returns Arabic messages of the guardian rules, see checkGuardiansRequired.
+func arGuardianMessages() map[string]string {
+	return map[string]string{
+		guardiansRequiredRule: "يجب أن يحتوي {0} على وصي واحد على الأقل للمرضى دون سن 18",
+		guardianPatientRule:   "يجب أن يكون {0} معرّف مريض موجود",
+		guardianSelfRule:      "لا يمكن أن يكون {0} معرّف المريض نفسه",
+	}
+}
+
+// isKnownSeverity reports whether a field holds a value of ppb.Patient_Severity defined by the proto.
+func isKnownSeverity(field validator.FieldLevel) bool {
+	_, known := ppb.Patient_Severity_name[int32(field.Field().Int())]
+	return known
+}
+
+// protoFieldName returns the proto name of a struct field, e.g. personal_id of PersonalID.
+func protoFieldName(name string) string {
+	runes := []rune(name)
+	var builder strings.Builder
+	for i, char := range runes {
+		// a word starts at an upper case letter that follows a lower case letter or precedes one,
+		// so acronyms such as ID form a single word
+		if i > 0 && unicode.IsUpper(char) &&
+			(unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			builder.WriteRune('_')
+		}
+		builder.WriteRune(unicode.ToLower(char))
+	}
+	return builder.String()
+}
+
+// translatorFromContext returns the translator of the language preferred by the client,
+// taken from the accept-language metadata. English is used if no preferred language is supported.
+func (server patientsServer) translatorFromContext(ctx context.Context) ut.Translator {
+	var locales []string
+	for _, header := range metadata.ValueFromIncomingContext(ctx, acceptLanguageMetadata) {
+		for _, language := range strings.Split(header, ",") {
+			// quality values are ignored, languages are usually listed by preference anyway
+			language, _, _ = strings.Cut(strings.TrimSpace(language), ";")
+			language, _, _ = strings.Cut(language, "-")
+			locales = append(locales, strings.ToLower(language))
+		}
+	}
+	translator, _ := server.translators.FindTranslator(append(locales, defaultLocale)...)
+	return translator
+}
+
+// validationError returns a codes.InvalidArgument error of a failed validation of a struct at the given field
+// of the request, or of the request itself if the field is empty.
+// Every invalid field is reported as a google.rpc.BadRequest field violation with the proto path of the field
+// in the request and a description in the language preferred by the client. The message of the error is in English.
+func (server patientsServer) validationError(ctx context.Context, field string, err error) error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	english, _ := server.translators.GetTranslator(defaultLocale)
+	translator := server.translatorFromContext(ctx)
+	violations := make([]*errdetails.BadRequest_FieldViolation, len(validationErrors))
+	messages := make([]string, len(validationErrors))
+	for i, fieldError := range validationErrors {
+		// the namespace starts with the name of the validated struct, e.g. Patient.personal_id.id
+		_, namespace, _ := strings.Cut(fieldError.Namespace(), ".")
+		path := fieldPath(field, namespace)
+		violations[i] = &errdetails.BadRequest_FieldViolation{
+			Field:       path,
+			Description: fieldError.Translate(translator),
+		}
+		messages[i] = path + ": " + fieldError.Translate(english)
+	}
+
+	invalid, detailsErr := status.New(codes.InvalidArgument, strings.Join(messages, "; ")).
+		WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if detailsErr != nil {
+		return status.Error(codes.InvalidArgument, strings.Join(messages, "; "))
+	}
+	return invalid.Err()
+}
+
+// ruleViolationError returns a codes.InvalidArgument error of a field at the given path that breaks a rule
+// checked outside of the validator, described like validationError describes failed validations.
+// Messages of the rule may refer to the name of the field as {0} and to the given params as {1} and on.
+func (server patientsServer) ruleViolationError(ctx context.Context, path string, field string, rule string,
+	params ...string) error {
+	english, _ := server.translators.GetTranslator(defaultLocale)
+	message, err := english.T(rule, append([]string{field}, params...)...)
+	if err != nil {
+		message = fmt.Sprintf("%s breaks the %s rule", field, rule)
+	}
+	description, err := server.translatorFromContext(ctx).T(rule, append([]string{field}, params...)...)
+	if err != nil {
+		description = message
+	}
+
+	invalid, err := status.New(codes.InvalidArgument, path+": "+message).WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: path, Description: description}},
+	})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, path+": "+message)
+	}
+	return invalid.Err()
+}
+
+// fieldPath returns the path of a field nested in the given parent field of a request,
+// or the path of the field itself if the parent is empty.
+func fieldPath(parent string, field string) string {
+	if parent == "" {
+		return field
+	}
+	return parent + fieldPathSeparator + field
+}
+
+// fieldViolationError returns a codes.InvalidArgument error of a single invalid field
+// with a google.rpc.BadRequest field violation of the field.
+func fieldViolationError(field string, description string) error {
+	invalid, err := status.New(codes.InvalidArgument, description).WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}},
+	})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, description)
+	}
+	return invalid.Err()
+}